@@ -0,0 +1,453 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Sub-resources that can be requested with GetCartOptions.Include.
+const (
+	CartIncludeRedirectURLs        = "redirect_urls"
+	CartIncludePhysicalItemOptions = "line_items.physical_items.options"
+	CartIncludeDigitalItemOptions  = "line_items.digital_items.options"
+	CartIncludePromotionsBanners   = "promotions.banners"
+)
+
+type CartService interface {
+	Get(string, ...GetCartOptions) (Cart, error)
+	GetWithContext(context.Context, string, ...GetCartOptions) (Cart, error)
+	Create(CartRequest, ...GetCartOptions) (Cart, error)
+	CreateWithContext(context.Context, CartRequest, ...GetCartOptions) (Cart, error)
+	UpdateCustomer(string, int, ...GetCartOptions) (Cart, error)
+	UpdateCustomerWithContext(context.Context, string, int, ...GetCartOptions) (Cart, error)
+	Delete(string) error
+	DeleteWithContext(context.Context, string) error
+	AddItems(string, CartItemsRequest, ...GetCartOptions) (Cart, error)
+	AddItemsWithContext(context.Context, string, CartItemsRequest, ...GetCartOptions) (Cart, error)
+	UpdateItem(string, string, CartLineItemRequest, ...GetCartOptions) (Cart, error)
+	UpdateItemWithContext(context.Context, string, string, CartLineItemRequest, ...GetCartOptions) (Cart, error)
+	DeleteItem(string, string, ...GetCartOptions) (Cart, error)
+	DeleteItemWithContext(context.Context, string, string, ...GetCartOptions) (Cart, error)
+	CreateRedirectURLs(string) (CartRedirectURLs, error)
+	CreateRedirectURLsWithContext(context.Context, string) (CartRedirectURLs, error)
+}
+
+type GetCartResponse struct {
+	Data Cart `json:"data"`
+}
+
+type GetCartRedirectURLsResponse struct {
+	Data CartRedirectURLs `json:"data"`
+}
+
+// Cart structure.
+type Cart struct {
+	ID             string            `json:"id"`
+	CustomerID     int               `json:"customer_id"`
+	ChannelID      int               `json:"channel_id"`
+	Email          string            `json:"email"`
+	Currency       CartCurrency      `json:"currency"`
+	TaxIncluded    bool              `json:"tax_included"`
+	BaseAmount     float64           `json:"base_amount"`
+	DiscountAmount float64           `json:"discount_amount"`
+	CartAmount     float64           `json:"cart_amount"`
+	Coupons        []CartCoupon      `json:"coupons"`
+	Discounts      []CartDiscount    `json:"discounts"`
+	LineItems      CartLineItems     `json:"line_items"`
+	Locale         string            `json:"locale"`
+	CreatedTime    string            `json:"created_time"`
+	UpdatedTime    string            `json:"updated_time"`
+	RedirectURLs   *CartRedirectURLs `json:"redirect_urls,omitempty"`
+}
+
+// CartCurrency is the currency of a cart.
+type CartCurrency struct {
+	Code string `json:"code"`
+}
+
+// CartCoupon is a coupon applied to a cart or checkout.
+type CartCoupon struct {
+	ID               int     `json:"id"`
+	Code             string  `json:"code"`
+	DisplayName      string  `json:"display_name"`
+	CouponType       string  `json:"coupon_type"`
+	DiscountedAmount float64 `json:"discounted_amount"`
+}
+
+// CartDiscount is a discount applied to a cart or line item.
+type CartDiscount struct {
+	ID               interface{} `json:"id"`
+	DiscountedAmount float64     `json:"discounted_amount"`
+}
+
+// CartLineItems groups the items of a cart by kind.
+type CartLineItems struct {
+	PhysicalItems    []CartLineItem        `json:"physical_items"`
+	DigitalItems     []CartLineItem        `json:"digital_items"`
+	GiftCertificates []CartGiftCertificate `json:"gift_certificates"`
+	CustomItems      []CartCustomItem      `json:"custom_items"`
+}
+
+// CartLineItem is a catalog product in a cart.
+type CartLineItem struct {
+	ID                string           `json:"id"`
+	ParentID          int              `json:"parent_id"`
+	ProductID         int              `json:"product_id"`
+	VariantID         int              `json:"variant_id"`
+	SKU               string           `json:"sku"`
+	Name              string           `json:"name"`
+	URL               string           `json:"url"`
+	ImageURL          string           `json:"image_url"`
+	Quantity          int              `json:"quantity"`
+	IsTaxable         bool             `json:"is_taxable"`
+	IsRequireShipping bool             `json:"is_require_shipping"`
+	Discounts         []CartDiscount   `json:"discounts"`
+	DiscountAmount    float64          `json:"discount_amount"`
+	CouponAmount      float64          `json:"coupon_amount"`
+	OriginalPrice     float64          `json:"original_price"`
+	ListPrice         float64          `json:"list_price"`
+	SalePrice         float64          `json:"sale_price"`
+	ExtendedListPrice float64          `json:"extended_list_price"`
+	ExtendedSalePrice float64          `json:"extended_sale_price"`
+	Options           []CartItemOption `json:"options,omitempty"`
+}
+
+// CartItemOption is an option chosen for a cart line item.
+type CartItemOption struct {
+	Name    string `json:"name"`
+	NameID  int    `json:"nameId"`
+	Value   string `json:"value"`
+	ValueID int    `json:"valueId"`
+}
+
+// CartCustomItem is an item that is not in the catalog.
+type CartCustomItem struct {
+	ID                string  `json:"id,omitempty"`
+	SKU               string  `json:"sku"`
+	Name              string  `json:"name"`
+	Quantity          int     `json:"quantity"`
+	ListPrice         float64 `json:"list_price"`
+	ExtendedListPrice float64 `json:"extended_list_price,omitempty"`
+}
+
+// CartGiftCertificate is a gift certificate bought through a cart.
+type CartGiftCertificate struct {
+	ID        string          `json:"id,omitempty"`
+	Name      string          `json:"name"`
+	Theme     string          `json:"theme"`
+	Amount    float64         `json:"amount"`
+	Quantity  int             `json:"quantity"`
+	Taxable   bool            `json:"taxable,omitempty"`
+	Sender    CartGiftContact `json:"sender"`
+	Recipient CartGiftContact `json:"recipient"`
+	Message   string          `json:"message,omitempty"`
+}
+
+// CartGiftContact is the sender or recipient of a gift certificate.
+type CartGiftContact struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// CartRedirectURLs are the storefront URLs of a cart.
+type CartRedirectURLs struct {
+	CartURL             string `json:"cart_url"`
+	CheckoutURL         string `json:"checkout_url"`
+	EmbeddedCheckoutURL string `json:"embedded_checkout_url"`
+}
+
+// CartRequest is the body used to create a cart.
+type CartRequest struct {
+	CustomerID       int                   `json:"customer_id,omitempty"`
+	ChannelID        int                   `json:"channel_id,omitempty"`
+	Currency         *CartCurrency         `json:"currency,omitempty"`
+	Locale           string                `json:"locale,omitempty"`
+	LineItems        []CartLineItemRequest `json:"line_items,omitempty"`
+	CustomItems      []CartCustomItem      `json:"custom_items,omitempty"`
+	GiftCertificates []CartGiftCertificate `json:"gift_certificates,omitempty"`
+}
+
+// CartItemsRequest is the body used to add items to a cart.
+type CartItemsRequest struct {
+	LineItems        []CartLineItemRequest `json:"line_items,omitempty"`
+	CustomItems      []CartCustomItem      `json:"custom_items,omitempty"`
+	GiftCertificates []CartGiftCertificate `json:"gift_certificates,omitempty"`
+}
+
+// CartLineItemRequest adds or updates a catalog product in a cart.
+// ListPrice overrides the catalog price.
+type CartLineItemRequest struct {
+	ProductID        int                   `json:"product_id"`
+	VariantID        int                   `json:"variant_id,omitempty"`
+	Quantity         int                   `json:"quantity"`
+	ListPrice        float64               `json:"list_price,omitempty"`
+	OptionSelections []CartOptionSelection `json:"option_selections,omitempty"`
+}
+
+// CartOptionSelection chooses a value for a product option or modifier.
+type CartOptionSelection struct {
+	OptionID    int         `json:"option_id"`
+	OptionValue interface{} `json:"option_value"`
+}
+
+// GetCartOptions controls the sub-resources returned with a cart.
+type GetCartOptions struct {
+	Include []string `url:"include,omitempty"`
+}
+
+type cartCustomer struct {
+	CustomerID int `json:"customer_id"`
+}
+
+type cartLineItemUpdate struct {
+	LineItem CartLineItemRequest `json:"line_item"`
+}
+
+type CartServiceOp struct {
+	client *Client
+}
+
+// Get will fetch a single cart by the provided ID.
+func (s *CartServiceOp) Get(cartID string, options ...GetCartOptions) (Cart, error) {
+	return s.GetWithContext(context.Background(), cartID, options...)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *CartServiceOp) GetWithContext(ctx context.Context, cartID string, options ...GetCartOptions) (Cart, error) {
+	cartResponse := GetCartResponse{}
+
+	var getOptions GetCartOptions
+	if len(options) > 0 {
+		getOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/carts/%s", cartID), getOptions)
+	if err != nil {
+		return cartResponse.Data, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return cartResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &cartResponse)
+	if jsonErr != nil {
+		return cartResponse.Data, jsonErr
+	}
+	return cartResponse.Data, nil
+}
+
+// Create will create a new cart.
+// The fields required on a cart are: LineItems or CustomItems, and ChannelID for channels other than the storefront.
+func (s *CartServiceOp) Create(cart CartRequest, options ...GetCartOptions) (Cart, error) {
+	return s.CreateWithContext(context.Background(), cart, options...)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *CartServiceOp) CreateWithContext(ctx context.Context, cart CartRequest, options ...GetCartOptions) (Cart, error) {
+	cartResponse := GetCartResponse{}
+
+	var getOptions GetCartOptions
+	if len(options) > 0 {
+		getOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/carts", getOptions)
+	if err != nil {
+		return cartResponse.Data, err
+	}
+
+	jsonBody, err := json.Marshal(cart)
+	if err != nil {
+		return cartResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, path, reqBody)
+	if reqErr != nil {
+		return cartResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &cartResponse)
+	if jsonErr != nil {
+		return cartResponse.Data, jsonErr
+	}
+	return cartResponse.Data, nil
+}
+
+// UpdateCustomer will assign a cart to a customer. Prices are recalculated for the customer's group.
+func (s *CartServiceOp) UpdateCustomer(cartID string, customerID int, options ...GetCartOptions) (Cart, error) {
+	return s.UpdateCustomerWithContext(context.Background(), cartID, customerID, options...)
+}
+
+// UpdateCustomerWithContext is the context-aware variant of UpdateCustomer.
+func (s *CartServiceOp) UpdateCustomerWithContext(ctx context.Context, cartID string, customerID int, options ...GetCartOptions) (Cart, error) {
+	cartResponse := GetCartResponse{}
+
+	var getOptions GetCartOptions
+	if len(options) > 0 {
+		getOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/carts/%s", cartID), getOptions)
+	if err != nil {
+		return cartResponse.Data, err
+	}
+
+	jsonBody, err := json.Marshal(cartCustomer{CustomerID: customerID})
+	if err != nil {
+		return cartResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, path, reqBody)
+	if reqErr != nil {
+		return cartResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &cartResponse)
+	if jsonErr != nil {
+		return cartResponse.Data, jsonErr
+	}
+	return cartResponse.Data, nil
+}
+
+// Delete will delete a cart by the provided ID.
+func (s *CartServiceOp) Delete(cartID string) error {
+	return s.DeleteWithContext(context.Background(), cartID)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *CartServiceOp) DeleteWithContext(ctx context.Context, cartID string) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/carts/%s", cartID), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// AddItems will add line items, custom items or gift certificates to a cart.
+func (s *CartServiceOp) AddItems(cartID string, items CartItemsRequest, options ...GetCartOptions) (Cart, error) {
+	return s.AddItemsWithContext(context.Background(), cartID, items, options...)
+}
+
+// AddItemsWithContext is the context-aware variant of AddItems.
+func (s *CartServiceOp) AddItemsWithContext(ctx context.Context, cartID string, items CartItemsRequest, options ...GetCartOptions) (Cart, error) {
+	cartResponse := GetCartResponse{}
+
+	var getOptions GetCartOptions
+	if len(options) > 0 {
+		getOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/carts/%s/items", cartID), getOptions)
+	if err != nil {
+		return cartResponse.Data, err
+	}
+
+	jsonBody, err := json.Marshal(items)
+	if err != nil {
+		return cartResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, path, reqBody)
+	if reqErr != nil {
+		return cartResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &cartResponse)
+	if jsonErr != nil {
+		return cartResponse.Data, jsonErr
+	}
+	return cartResponse.Data, nil
+}
+
+// UpdateItem will update a line item of a cart, such as its quantity.
+func (s *CartServiceOp) UpdateItem(cartID, itemID string, item CartLineItemRequest, options ...GetCartOptions) (Cart, error) {
+	return s.UpdateItemWithContext(context.Background(), cartID, itemID, item, options...)
+}
+
+// UpdateItemWithContext is the context-aware variant of UpdateItem.
+func (s *CartServiceOp) UpdateItemWithContext(ctx context.Context, cartID, itemID string, item CartLineItemRequest, options ...GetCartOptions) (Cart, error) {
+	cartResponse := GetCartResponse{}
+
+	var getOptions GetCartOptions
+	if len(options) > 0 {
+		getOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/carts/%s/items/%s", cartID, itemID), getOptions)
+	if err != nil {
+		return cartResponse.Data, err
+	}
+
+	jsonBody, err := json.Marshal(cartLineItemUpdate{LineItem: item})
+	if err != nil {
+		return cartResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, path, reqBody)
+	if reqErr != nil {
+		return cartResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &cartResponse)
+	if jsonErr != nil {
+		return cartResponse.Data, jsonErr
+	}
+	return cartResponse.Data, nil
+}
+
+// DeleteItem will remove a line item from a cart.
+// Removing the last item deletes the cart, in which case an empty Cart is returned.
+func (s *CartServiceOp) DeleteItem(cartID, itemID string, options ...GetCartOptions) (Cart, error) {
+	return s.DeleteItemWithContext(context.Background(), cartID, itemID, options...)
+}
+
+// DeleteItemWithContext is the context-aware variant of DeleteItem.
+func (s *CartServiceOp) DeleteItemWithContext(ctx context.Context, cartID, itemID string, options ...GetCartOptions) (Cart, error) {
+	cartResponse := GetCartResponse{}
+
+	var getOptions GetCartOptions
+	if len(options) > 0 {
+		getOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/carts/%s/items/%s", cartID, itemID), getOptions)
+	if err != nil {
+		return cartResponse.Data, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, path, nil)
+	if reqErr != nil {
+		return cartResponse.Data, reqErr
+	}
+
+	jsonErr := unmarshalOptional(body, &cartResponse)
+	if jsonErr != nil {
+		return cartResponse.Data, jsonErr
+	}
+	return cartResponse.Data, nil
+}
+
+// CreateRedirectURLs will create the URLs that hand a cart over to the storefront cart and checkout pages.
+func (s *CartServiceOp) CreateRedirectURLs(cartID string) (CartRedirectURLs, error) {
+	return s.CreateRedirectURLsWithContext(context.Background(), cartID)
+}
+
+// CreateRedirectURLsWithContext is the context-aware variant of CreateRedirectURLs.
+func (s *CartServiceOp) CreateRedirectURLsWithContext(ctx context.Context, cartID string) (CartRedirectURLs, error) {
+	urlsResponse := GetCartRedirectURLsResponse{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("/v3/carts/%s/redirect_urls", cartID), nil)
+	if reqErr != nil {
+		return urlsResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &urlsResponse)
+	if jsonErr != nil {
+		return urlsResponse.Data, jsonErr
+	}
+	return urlsResponse.Data, nil
+}
@@ -0,0 +1,13 @@
+package bigcommerce
+
+// CatalogService groups the v3 catalog services.
+type CatalogService struct {
+	Products      ProductService
+	ProductImages ProductImageService
+	Variants      VariantService
+	Options       ProductOptionService
+	Modifiers     ProductModifierService
+	Categories    CategoryService
+	CategoryTrees CategoryTreeService
+	Brands        BrandService
+}
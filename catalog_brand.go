@@ -0,0 +1,372 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type BrandService interface {
+	Get(int) (Brand, error)
+	GetWithContext(context.Context, int) (Brand, error)
+	List(...ListBrandsOptions) (ListBrandResponse, error)
+	ListWithContext(context.Context, ...ListBrandsOptions) (ListBrandResponse, error)
+	Create(Brand) (Brand, error)
+	CreateWithContext(context.Context, Brand) (Brand, error)
+	Update(Brand) (Brand, error)
+	UpdateWithContext(context.Context, Brand) (Brand, error)
+	Delete(int) error
+	DeleteWithContext(context.Context, int) error
+	Iterate(context.Context, IteratorOptions, ...ListBrandsOptions) *Iterator
+	UploadImage(int, ImageUpload) (string, error)
+	UploadImageWithContext(context.Context, int, ImageUpload) (string, error)
+	DeleteImage(int) error
+	DeleteImageWithContext(context.Context, int) error
+	GetMetafield(int, int) (Metafield, error)
+	GetMetafieldWithContext(context.Context, int, int) (Metafield, error)
+	ListMetafields(int, ...ListMetafieldsOptions) (ListMetafieldResponse, error)
+	ListMetafieldsWithContext(context.Context, int, ...ListMetafieldsOptions) (ListMetafieldResponse, error)
+	CreateMetafield(int, Metafield) (Metafield, error)
+	CreateMetafieldWithContext(context.Context, int, Metafield) (Metafield, error)
+	UpdateMetafield(int, Metafield) (Metafield, error)
+	UpdateMetafieldWithContext(context.Context, int, Metafield) (Metafield, error)
+	DeleteMetafield(int, int) error
+	DeleteMetafieldWithContext(context.Context, int, int) error
+}
+
+type GetBrandResponse struct {
+	Data Brand `json:"data"`
+}
+
+type ListBrandResponse struct {
+	Data []Brand    `json:"data"`
+	Meta MetaResult `json:"meta"`
+}
+
+// Brand structure.
+type Brand struct {
+	ID              int        `json:"id,omitempty"`
+	Name            string     `json:"name,omitempty"`
+	PageTitle       string     `json:"page_title,omitempty"`
+	MetaKeywords    []string   `json:"meta_keywords,omitempty"`
+	MetaDescription string     `json:"meta_description,omitempty"`
+	SearchKeywords  string     `json:"search_keywords,omitempty"`
+	ImageURL        string     `json:"image_url,omitempty"`
+	CustomURL       *CustomURL `json:"custom_url,omitempty"`
+}
+
+// ListBrandsOptions filters the brands returned by List.
+type ListBrandsOptions struct {
+	ListOptions
+	ID            int      `url:"id,omitempty"`
+	IDIn          []int    `url:"id:in,omitempty"`
+	IDNotIn       []int    `url:"id:not_in,omitempty"`
+	IDMin         int      `url:"id:min,omitempty"`
+	IDMax         int      `url:"id:max,omitempty"`
+	Name          string   `url:"name,omitempty"`
+	NameLike      string   `url:"name:like,omitempty"`
+	PageTitle     string   `url:"page_title,omitempty"`
+	IncludeFields []string `url:"include_fields,omitempty"`
+	ExcludeFields []string `url:"exclude_fields,omitempty"`
+}
+
+type BrandServiceOp struct {
+	client *Client
+}
+
+// Get will fetch a single brand by the provided ID.
+func (s *BrandServiceOp) Get(id int) (Brand, error) {
+	return s.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *BrandServiceOp) GetWithContext(ctx context.Context, id int) (Brand, error) {
+	brandResponse := GetBrandResponse{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/catalog/brands/%d", id), nil)
+	if reqErr != nil {
+		return brandResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &brandResponse)
+	if jsonErr != nil {
+		return brandResponse.Data, jsonErr
+	}
+	return brandResponse.Data, nil
+}
+
+// List will return a page of brands matching the options.
+func (s *BrandServiceOp) List(options ...ListBrandsOptions) (ListBrandResponse, error) {
+	return s.ListWithContext(context.Background(), options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *BrandServiceOp) ListWithContext(ctx context.Context, options ...ListBrandsOptions) (ListBrandResponse, error) {
+	listResult := ListBrandResponse{}
+
+	var listOptions ListBrandsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/catalog/brands", listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// Create will create a new brand. Name is the only required field.
+func (s *BrandServiceOp) Create(brand Brand) (Brand, error) {
+	return s.CreateWithContext(context.Background(), brand)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *BrandServiceOp) CreateWithContext(ctx context.Context, brand Brand) (Brand, error) {
+	brandResponse := GetBrandResponse{}
+	jsonBody, err := json.Marshal(brand)
+	if err != nil {
+		return brandResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, "/v3/catalog/brands", reqBody)
+	if reqErr != nil {
+		return brandResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &brandResponse)
+	if jsonErr != nil {
+		return brandResponse.Data, jsonErr
+	}
+	return brandResponse.Data, nil
+}
+
+// Update will update a single brand.
+func (s *BrandServiceOp) Update(brand Brand) (Brand, error) {
+	return s.UpdateWithContext(context.Background(), brand)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *BrandServiceOp) UpdateWithContext(ctx context.Context, brand Brand) (Brand, error) {
+	brandResponse := GetBrandResponse{}
+	jsonBody, err := json.Marshal(brand)
+	if err != nil {
+		return brandResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/catalog/brands/%d", brand.ID), reqBody)
+	if reqErr != nil {
+		return brandResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &brandResponse)
+	if jsonErr != nil {
+		return brandResponse.Data, jsonErr
+	}
+	return brandResponse.Data, nil
+}
+
+// Delete will delete a brand by the provided ID.
+func (s *BrandServiceOp) Delete(id int) error {
+	return s.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *BrandServiceOp) DeleteWithContext(ctx context.Context, id int) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/catalog/brands/%d", id), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// Iterate will walk every brand matching the options, fetching further pages as needed.
+func (s *BrandServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, options ...ListBrandsOptions) *Iterator {
+	var listOptions ListBrandsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListWithContext(ctx, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
+
+// UploadImage will set the image of a brand and return the image URL.
+// Files are uploaded; an ImageURL is stored on the brand as is.
+func (s *BrandServiceOp) UploadImage(brandID int, upload ImageUpload) (string, error) {
+	return s.UploadImageWithContext(context.Background(), brandID, upload)
+}
+
+// UploadImageWithContext is the context-aware variant of UploadImage.
+func (s *BrandServiceOp) UploadImageWithContext(ctx context.Context, brandID int, upload ImageUpload) (string, error) {
+	if err := upload.validate(); err != nil {
+		return "", err
+	}
+
+	if !upload.isFile() {
+		brand, err := s.UpdateWithContext(ctx, Brand{ID: brandID, ImageURL: upload.ImageURL})
+		return brand.ImageURL, err
+	}
+
+	imageResponse := ImageURLResponse{}
+	body, reqErr := s.client.uploadImage(ctx, http.MethodPost, fmt.Sprintf("/v3/catalog/brands/%d/image", brandID), upload, nil)
+	if reqErr != nil {
+		return imageResponse.Data.ImageURL, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &imageResponse)
+	if jsonErr != nil {
+		return imageResponse.Data.ImageURL, jsonErr
+	}
+	return imageResponse.Data.ImageURL, nil
+}
+
+// DeleteImage will remove the image of a brand.
+func (s *BrandServiceOp) DeleteImage(brandID int) error {
+	return s.DeleteImageWithContext(context.Background(), brandID)
+}
+
+// DeleteImageWithContext is the context-aware variant of DeleteImage.
+func (s *BrandServiceOp) DeleteImageWithContext(ctx context.Context, brandID int) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/catalog/brands/%d/image", brandID), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// GetMetafield will fetch a single metafield of a brand.
+func (s *BrandServiceOp) GetMetafield(brandID, metafieldID int) (Metafield, error) {
+	return s.GetMetafieldWithContext(context.Background(), brandID, metafieldID)
+}
+
+// GetMetafieldWithContext is the context-aware variant of GetMetafield.
+func (s *BrandServiceOp) GetMetafieldWithContext(ctx context.Context, brandID, metafieldID int) (Metafield, error) {
+	metafieldResponse := GetMetafieldResponse{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/catalog/brands/%d/metafields/%d", brandID, metafieldID), nil)
+	if reqErr != nil {
+		return metafieldResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &metafieldResponse)
+	if jsonErr != nil {
+		return metafieldResponse.Data, jsonErr
+	}
+	return metafieldResponse.Data, nil
+}
+
+// ListMetafields will return a page of a brand's metafields matching the options.
+func (s *BrandServiceOp) ListMetafields(brandID int, options ...ListMetafieldsOptions) (ListMetafieldResponse, error) {
+	return s.ListMetafieldsWithContext(context.Background(), brandID, options...)
+}
+
+// ListMetafieldsWithContext is the context-aware variant of ListMetafields.
+func (s *BrandServiceOp) ListMetafieldsWithContext(ctx context.Context, brandID int, options ...ListMetafieldsOptions) (ListMetafieldResponse, error) {
+	listResult := ListMetafieldResponse{}
+
+	var listOptions ListMetafieldsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/catalog/brands/%d/metafields", brandID), listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// CreateMetafield will add a metafield to a brand.
+// The fields required on a metafield are: Key, Value, Namespace and PermissionSet.
+func (s *BrandServiceOp) CreateMetafield(brandID int, metafield Metafield) (Metafield, error) {
+	return s.CreateMetafieldWithContext(context.Background(), brandID, metafield)
+}
+
+// CreateMetafieldWithContext is the context-aware variant of CreateMetafield.
+func (s *BrandServiceOp) CreateMetafieldWithContext(ctx context.Context, brandID int, metafield Metafield) (Metafield, error) {
+	metafieldResponse := GetMetafieldResponse{}
+	jsonBody, err := json.Marshal(metafield)
+	if err != nil {
+		return metafieldResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("/v3/catalog/brands/%d/metafields", brandID), reqBody)
+	if reqErr != nil {
+		return metafieldResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &metafieldResponse)
+	if jsonErr != nil {
+		return metafieldResponse.Data, jsonErr
+	}
+	return metafieldResponse.Data, nil
+}
+
+// UpdateMetafield will update a single metafield of a brand.
+func (s *BrandServiceOp) UpdateMetafield(brandID int, metafield Metafield) (Metafield, error) {
+	return s.UpdateMetafieldWithContext(context.Background(), brandID, metafield)
+}
+
+// UpdateMetafieldWithContext is the context-aware variant of UpdateMetafield.
+func (s *BrandServiceOp) UpdateMetafieldWithContext(ctx context.Context, brandID int, metafield Metafield) (Metafield, error) {
+	metafieldResponse := GetMetafieldResponse{}
+	jsonBody, err := json.Marshal(metafield)
+	if err != nil {
+		return metafieldResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/catalog/brands/%d/metafields/%d", brandID, metafield.ID), reqBody)
+	if reqErr != nil {
+		return metafieldResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &metafieldResponse)
+	if jsonErr != nil {
+		return metafieldResponse.Data, jsonErr
+	}
+	return metafieldResponse.Data, nil
+}
+
+// DeleteMetafield will remove a metafield from a brand.
+func (s *BrandServiceOp) DeleteMetafield(brandID, metafieldID int) error {
+	return s.DeleteMetafieldWithContext(context.Background(), brandID, metafieldID)
+}
+
+// DeleteMetafieldWithContext is the context-aware variant of DeleteMetafield.
+func (s *BrandServiceOp) DeleteMetafieldWithContext(ctx context.Context, brandID, metafieldID int) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/catalog/brands/%d/metafields/%d", brandID, metafieldID), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
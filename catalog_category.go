@@ -0,0 +1,283 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type CategoryService interface {
+	Get(int) (Category, error)
+	GetWithContext(context.Context, int) (Category, error)
+	List(...ListCategoriesOptions) (ListCategoryResponse, error)
+	ListWithContext(context.Context, ...ListCategoriesOptions) (ListCategoryResponse, error)
+	Create(Category) (Category, error)
+	CreateWithContext(context.Context, Category) (Category, error)
+	Update(Category) (Category, error)
+	UpdateWithContext(context.Context, Category) (Category, error)
+	Delete(int) error
+	DeleteWithContext(context.Context, int) error
+	DeleteMany([]int) error
+	DeleteManyWithContext(context.Context, []int) error
+	Iterate(context.Context, IteratorOptions, ...ListCategoriesOptions) *Iterator
+	UploadImage(int, ImageUpload) (string, error)
+	UploadImageWithContext(context.Context, int, ImageUpload) (string, error)
+	DeleteImage(int) error
+	DeleteImageWithContext(context.Context, int) error
+}
+
+type GetCategoryResponse struct {
+	Data Category `json:"data"`
+}
+
+type ListCategoryResponse struct {
+	Data []Category `json:"data"`
+	Meta MetaResult `json:"meta"`
+}
+
+// Category structure.
+// ParentID is left unchanged by updates when nil; Int(0) moves the category
+// to the top level.
+type Category struct {
+	ID                 int        `json:"id,omitempty"`
+	ParentID           *int       `json:"parent_id,omitempty"`
+	Name               string     `json:"name,omitempty"`
+	Description        string     `json:"description,omitempty"`
+	Views              int        `json:"views,omitempty"`
+	SortOrder          int        `json:"sort_order,omitempty"`
+	PageTitle          string     `json:"page_title,omitempty"`
+	SearchKeywords     string     `json:"search_keywords,omitempty"`
+	MetaKeywords       []string   `json:"meta_keywords,omitempty"`
+	MetaDescription    string     `json:"meta_description,omitempty"`
+	LayoutFile         string     `json:"layout_file,omitempty"`
+	IsVisible          *bool      `json:"is_visible,omitempty"`
+	DefaultProductSort string     `json:"default_product_sort,omitempty"`
+	ImageURL           string     `json:"image_url,omitempty"`
+	CustomURL          *CustomURL `json:"custom_url,omitempty"`
+}
+
+// ListCategoriesOptions filters the categories returned by List.
+type ListCategoriesOptions struct {
+	ListOptions
+	ID            int      `url:"id,omitempty"`
+	IDIn          []int    `url:"id:in,omitempty"`
+	IDNotIn       []int    `url:"id:not_in,omitempty"`
+	Name          string   `url:"name,omitempty"`
+	NameLike      string   `url:"name:like,omitempty"`
+	ParentID      *int     `url:"parent_id,omitempty"`
+	ParentIDIn    []int    `url:"parent_id:in,omitempty"`
+	PageTitle     string   `url:"page_title,omitempty"`
+	Keyword       string   `url:"keyword,omitempty"`
+	IsVisible     *bool    `url:"is_visible,omitempty"`
+	IncludeFields []string `url:"include_fields,omitempty"`
+	ExcludeFields []string `url:"exclude_fields,omitempty"`
+}
+
+type CategoryServiceOp struct {
+	client *Client
+}
+
+// Get will fetch a single category by the provided ID.
+func (s *CategoryServiceOp) Get(id int) (Category, error) {
+	return s.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *CategoryServiceOp) GetWithContext(ctx context.Context, id int) (Category, error) {
+	categoryResponse := GetCategoryResponse{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/catalog/categories/%d", id), nil)
+	if reqErr != nil {
+		return categoryResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &categoryResponse)
+	if jsonErr != nil {
+		return categoryResponse.Data, jsonErr
+	}
+	return categoryResponse.Data, nil
+}
+
+// List will return a page of categories matching the options.
+func (s *CategoryServiceOp) List(options ...ListCategoriesOptions) (ListCategoryResponse, error) {
+	return s.ListWithContext(context.Background(), options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *CategoryServiceOp) ListWithContext(ctx context.Context, options ...ListCategoriesOptions) (ListCategoryResponse, error) {
+	listResult := ListCategoryResponse{}
+
+	var listOptions ListCategoriesOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/catalog/categories", listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// Create will create a new category.
+// The fields required on a category are: ParentID and Name.
+func (s *CategoryServiceOp) Create(category Category) (Category, error) {
+	return s.CreateWithContext(context.Background(), category)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *CategoryServiceOp) CreateWithContext(ctx context.Context, category Category) (Category, error) {
+	categoryResponse := GetCategoryResponse{}
+	jsonBody, err := json.Marshal(category)
+	if err != nil {
+		return categoryResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, "/v3/catalog/categories", reqBody)
+	if reqErr != nil {
+		return categoryResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &categoryResponse)
+	if jsonErr != nil {
+		return categoryResponse.Data, jsonErr
+	}
+	return categoryResponse.Data, nil
+}
+
+// Update will update a single category.
+func (s *CategoryServiceOp) Update(category Category) (Category, error) {
+	return s.UpdateWithContext(context.Background(), category)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *CategoryServiceOp) UpdateWithContext(ctx context.Context, category Category) (Category, error) {
+	categoryResponse := GetCategoryResponse{}
+	jsonBody, err := json.Marshal(category)
+	if err != nil {
+		return categoryResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/catalog/categories/%d", category.ID), reqBody)
+	if reqErr != nil {
+		return categoryResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &categoryResponse)
+	if jsonErr != nil {
+		return categoryResponse.Data, jsonErr
+	}
+	return categoryResponse.Data, nil
+}
+
+// Delete will delete a category by the provided ID.
+func (s *CategoryServiceOp) Delete(id int) error {
+	return s.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *CategoryServiceOp) DeleteWithContext(ctx context.Context, id int) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/catalog/categories/%d", id), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// DeleteMany will delete every category with one of the provided IDs.
+func (s *CategoryServiceOp) DeleteMany(ids []int) error {
+	return s.DeleteManyWithContext(context.Background(), ids)
+}
+
+// DeleteManyWithContext is the context-aware variant of DeleteMany.
+func (s *CategoryServiceOp) DeleteManyWithContext(ctx context.Context, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	path, err := addQuery("/v3/catalog/categories", ListCategoriesOptions{IDIn: ids})
+	if err != nil {
+		return err
+	}
+
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, path, nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// Iterate will walk every category matching the options, fetching further pages as needed.
+func (s *CategoryServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, options ...ListCategoriesOptions) *Iterator {
+	var listOptions ListCategoriesOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListWithContext(ctx, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
+
+// UploadImage will set the image of a category and return the image URL.
+// Files are uploaded; an ImageURL is stored on the category as is.
+func (s *CategoryServiceOp) UploadImage(categoryID int, upload ImageUpload) (string, error) {
+	return s.UploadImageWithContext(context.Background(), categoryID, upload)
+}
+
+// UploadImageWithContext is the context-aware variant of UploadImage.
+func (s *CategoryServiceOp) UploadImageWithContext(ctx context.Context, categoryID int, upload ImageUpload) (string, error) {
+	if err := upload.validate(); err != nil {
+		return "", err
+	}
+
+	if !upload.isFile() {
+		category, err := s.UpdateWithContext(ctx, Category{ID: categoryID, ImageURL: upload.ImageURL})
+		return category.ImageURL, err
+	}
+
+	imageResponse := ImageURLResponse{}
+	body, reqErr := s.client.uploadImage(ctx, http.MethodPost, fmt.Sprintf("/v3/catalog/categories/%d/image", categoryID), upload, nil)
+	if reqErr != nil {
+		return imageResponse.Data.ImageURL, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &imageResponse)
+	if jsonErr != nil {
+		return imageResponse.Data.ImageURL, jsonErr
+	}
+	return imageResponse.Data.ImageURL, nil
+}
+
+// DeleteImage will remove the image of a category.
+func (s *CategoryServiceOp) DeleteImage(categoryID int) error {
+	return s.DeleteImageWithContext(context.Background(), categoryID)
+}
+
+// DeleteImageWithContext is the context-aware variant of DeleteImage.
+func (s *CategoryServiceOp) DeleteImageWithContext(ctx context.Context, categoryID int) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/catalog/categories/%d/image", categoryID), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
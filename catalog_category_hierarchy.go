@@ -0,0 +1,133 @@
+package bigcommerce
+
+import "sort"
+
+// CategoryNode is a category within an in-memory hierarchy. It is returned by
+// CategoryTreeService.GetCategories and built by the CategoryTreeFrom helpers.
+type CategoryNode struct {
+	ID        int             `json:"id"`
+	ParentID  int             `json:"parent_id"`
+	Depth     int             `json:"depth"`
+	Path      []int           `json:"path"`
+	Name      string          `json:"name"`
+	IsVisible bool            `json:"is_visible"`
+	URL       string          `json:"url"`
+	SortOrder int             `json:"-"`
+	Children  []*CategoryNode `json:"children"`
+}
+
+// CategoryTreeFromCategories builds the hierarchy of categories from their
+// ParentID, returning the top level nodes. Categories whose parent isn't in
+// the list are treated as top level.
+func CategoryTreeFromCategories(categories []Category) []*CategoryNode {
+	nodes := make([]*CategoryNode, 0, len(categories))
+	for _, c := range categories {
+		node := &CategoryNode{
+			ID:        c.ID,
+			ParentID:  intValue(c.ParentID),
+			Name:      c.Name,
+			IsVisible: c.IsVisible != nil && *c.IsVisible,
+			SortOrder: c.SortOrder,
+		}
+		if c.CustomURL != nil {
+			node.URL = c.CustomURL.URL
+		}
+		nodes = append(nodes, node)
+	}
+
+	return linkCategoryNodes(nodes)
+}
+
+// CategoryTreeFromTreeCategories builds the hierarchy of tree categories from
+// their ParentID, returning the top level nodes. Categories whose parent isn't
+// in the list are treated as top level.
+func CategoryTreeFromTreeCategories(categories []TreeCategory) []*CategoryNode {
+	nodes := make([]*CategoryNode, 0, len(categories))
+	for _, c := range categories {
+		node := &CategoryNode{
+			ID:        c.CategoryID,
+			ParentID:  intValue(c.ParentID),
+			Name:      c.Name,
+			IsVisible: c.IsVisible != nil && *c.IsVisible,
+			SortOrder: c.SortOrder,
+		}
+		if c.URL != nil {
+			node.URL = c.URL.Path
+		}
+		nodes = append(nodes, node)
+	}
+
+	return linkCategoryNodes(nodes)
+}
+
+// linkCategoryNodes attaches every node to its parent, ordering siblings by
+// SortOrder then name, and fills in Depth and Path.
+func linkCategoryNodes(nodes []*CategoryNode) []*CategoryNode {
+	byID := make(map[int]*CategoryNode, len(nodes))
+	for _, node := range nodes {
+		byID[node.ID] = node
+	}
+
+	var roots []*CategoryNode
+	for _, node := range nodes {
+		parent, ok := byID[node.ParentID]
+		if !ok || node.ParentID == 0 || parent == node {
+			roots = append(roots, node)
+			continue
+		}
+		parent.Children = append(parent.Children, node)
+	}
+
+	var link func(siblings []*CategoryNode, depth int, path []int)
+	link = func(siblings []*CategoryNode, depth int, path []int) {
+		sort.SliceStable(siblings, func(i, j int) bool {
+			if siblings[i].SortOrder != siblings[j].SortOrder {
+				return siblings[i].SortOrder < siblings[j].SortOrder
+			}
+			return siblings[i].Name < siblings[j].Name
+		})
+		for _, node := range siblings {
+			node.Depth = depth
+			node.Path = append(append([]int{}, path...), node.ID)
+			link(node.Children, depth+1, node.Path)
+		}
+	}
+	link(roots, 1, nil)
+
+	return roots
+}
+
+// WalkCategoryTree calls fn for every node depth first, parents before their
+// children. Walking stops at the first error fn returns.
+func WalkCategoryTree(roots []*CategoryNode, fn func(node *CategoryNode) error) error {
+	for _, node := range roots {
+		if err := fn(node); err != nil {
+			return err
+		}
+		if err := WalkCategoryTree(node.Children, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindCategoryNode returns the node with the given ID, or nil if there is none.
+func FindCategoryNode(roots []*CategoryNode, id int) *CategoryNode {
+	for _, node := range roots {
+		if node.ID == id {
+			return node
+		}
+		if found := FindCategoryNode(node.Children, id); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// intValue returns the integer p points to, or zero when p is nil.
+func intValue(p *int) int {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
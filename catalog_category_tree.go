@@ -0,0 +1,323 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type CategoryTreeService interface {
+	List(...ListCategoryTreesOptions) (ListCategoryTreeResponse, error)
+	ListWithContext(context.Context, ...ListCategoryTreesOptions) (ListCategoryTreeResponse, error)
+	Upsert([]CategoryTree) ([]CategoryTree, error)
+	UpsertWithContext(context.Context, []CategoryTree) ([]CategoryTree, error)
+	Delete([]int) error
+	DeleteWithContext(context.Context, []int) error
+	GetCategories(int) ([]*CategoryNode, error)
+	GetCategoriesWithContext(context.Context, int) ([]*CategoryNode, error)
+	ListCategories(...ListTreeCategoriesOptions) (ListTreeCategoryResponse, error)
+	ListCategoriesWithContext(context.Context, ...ListTreeCategoriesOptions) (ListTreeCategoryResponse, error)
+	CreateCategories([]TreeCategory) ([]TreeCategory, error)
+	CreateCategoriesWithContext(context.Context, []TreeCategory) ([]TreeCategory, error)
+	UpdateCategories([]TreeCategory) error
+	UpdateCategoriesWithContext(context.Context, []TreeCategory) error
+	DeleteCategories([]int) error
+	DeleteCategoriesWithContext(context.Context, []int) error
+	IterateCategories(context.Context, IteratorOptions, ...ListTreeCategoriesOptions) *Iterator
+}
+
+type ListCategoryTreeResponse struct {
+	Data []CategoryTree `json:"data"`
+	Meta MetaResult     `json:"meta"`
+}
+
+type ListTreeCategoryResponse struct {
+	Data []TreeCategory `json:"data"`
+	Meta MetaResult     `json:"meta"`
+}
+
+type CategoryNodeResponse struct {
+	Data []*CategoryNode `json:"data"`
+}
+
+// CategoryTree structure.
+// A tree holds the category hierarchy of the channels it is assigned to.
+type CategoryTree struct {
+	ID       int    `json:"id,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Channels []int  `json:"channels,omitempty"`
+}
+
+// CategoryURL is the storefront path of a tree category.
+type CategoryURL struct {
+	Path         string `json:"path,omitempty"`
+	IsCustomized *bool  `json:"is_customized,omitempty"`
+}
+
+// TreeCategory structure, as used by the category trees endpoints.
+// ParentID is left unchanged by updates when nil; Int(0) moves the category
+// to the top level.
+type TreeCategory struct {
+	CategoryID         int          `json:"category_id,omitempty"`
+	CategoryUUID       string       `json:"category_uuid,omitempty"`
+	TreeID             int          `json:"tree_id,omitempty"`
+	ParentID           *int         `json:"parent_id,omitempty"`
+	Name               string       `json:"name,omitempty"`
+	Description        string       `json:"description,omitempty"`
+	Views              int          `json:"views,omitempty"`
+	SortOrder          int          `json:"sort_order,omitempty"`
+	PageTitle          string       `json:"page_title,omitempty"`
+	SearchKeywords     string       `json:"search_keywords,omitempty"`
+	MetaKeywords       []string     `json:"meta_keywords,omitempty"`
+	MetaDescription    string       `json:"meta_description,omitempty"`
+	LayoutFile         string       `json:"layout_file,omitempty"`
+	IsVisible          *bool        `json:"is_visible,omitempty"`
+	DefaultProductSort string       `json:"default_product_sort,omitempty"`
+	ImageURL           string       `json:"image_url,omitempty"`
+	URL                *CategoryURL `json:"url,omitempty"`
+}
+
+// ListCategoryTreesOptions filters the trees returned by List.
+type ListCategoryTreesOptions struct {
+	ListOptions
+	IDIn        []int `url:"id:in,omitempty"`
+	ChannelIDIn []int `url:"channel_id:in,omitempty"`
+}
+
+// ListTreeCategoriesOptions filters the categories returned by ListCategories.
+type ListTreeCategoriesOptions struct {
+	ListOptions
+	CategoryIDIn    []int    `url:"category_id:in,omitempty"`
+	CategoryIDNotIn []int    `url:"category_id:not_in,omitempty"`
+	CategoryUUIDIn  []string `url:"category_uuid:in,omitempty"`
+	TreeIDIn        []int    `url:"tree_id:in,omitempty"`
+	ParentIDIn      []int    `url:"parent_id:in,omitempty"`
+	Name            string   `url:"name,omitempty"`
+	NameLike        string   `url:"name:like,omitempty"`
+	PageTitle       string   `url:"page_title,omitempty"`
+	Keyword         string   `url:"keyword,omitempty"`
+	IsVisible       *bool    `url:"is_visible,omitempty"`
+}
+
+type CategoryTreeServiceOp struct {
+	client *Client
+}
+
+// List will return a page of category trees matching the options.
+func (s *CategoryTreeServiceOp) List(options ...ListCategoryTreesOptions) (ListCategoryTreeResponse, error) {
+	return s.ListWithContext(context.Background(), options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *CategoryTreeServiceOp) ListWithContext(ctx context.Context, options ...ListCategoryTreesOptions) (ListCategoryTreeResponse, error) {
+	listResult := ListCategoryTreeResponse{}
+
+	var listOptions ListCategoryTreesOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/catalog/trees", listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// Upsert will create the trees without an ID and update the others.
+func (s *CategoryTreeServiceOp) Upsert(trees []CategoryTree) ([]CategoryTree, error) {
+	return s.UpsertWithContext(context.Background(), trees)
+}
+
+// UpsertWithContext is the context-aware variant of Upsert.
+func (s *CategoryTreeServiceOp) UpsertWithContext(ctx context.Context, trees []CategoryTree) ([]CategoryTree, error) {
+	listResult := ListCategoryTreeResponse{}
+	jsonBody, err := json.Marshal(trees)
+	if err != nil {
+		return listResult.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, "/v3/catalog/trees", reqBody)
+	if reqErr != nil {
+		return listResult.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult.Data, jsonErr
+	}
+	return listResult.Data, nil
+}
+
+// Delete will delete the trees with the provided IDs.
+func (s *CategoryTreeServiceOp) Delete(ids []int) error {
+	return s.DeleteWithContext(context.Background(), ids)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *CategoryTreeServiceOp) DeleteWithContext(ctx context.Context, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	path, err := addQuery("/v3/catalog/trees", ListCategoryTreesOptions{IDIn: ids})
+	if err != nil {
+		return err
+	}
+
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, path, nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// GetCategories will fetch the full category hierarchy of a tree.
+func (s *CategoryTreeServiceOp) GetCategories(treeID int) ([]*CategoryNode, error) {
+	return s.GetCategoriesWithContext(context.Background(), treeID)
+}
+
+// GetCategoriesWithContext is the context-aware variant of GetCategories.
+func (s *CategoryTreeServiceOp) GetCategoriesWithContext(ctx context.Context, treeID int) ([]*CategoryNode, error) {
+	nodeResponse := CategoryNodeResponse{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/catalog/trees/%d/categories", treeID), nil)
+	if reqErr != nil {
+		return nodeResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &nodeResponse)
+	if jsonErr != nil {
+		return nodeResponse.Data, jsonErr
+	}
+	return nodeResponse.Data, nil
+}
+
+// ListCategories will return a page of categories across trees matching the options.
+func (s *CategoryTreeServiceOp) ListCategories(options ...ListTreeCategoriesOptions) (ListTreeCategoryResponse, error) {
+	return s.ListCategoriesWithContext(context.Background(), options...)
+}
+
+// ListCategoriesWithContext is the context-aware variant of ListCategories.
+func (s *CategoryTreeServiceOp) ListCategoriesWithContext(ctx context.Context, options ...ListTreeCategoriesOptions) (ListTreeCategoryResponse, error) {
+	listResult := ListTreeCategoryResponse{}
+
+	var listOptions ListTreeCategoriesOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/catalog/trees/categories", listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// CreateCategories will create a batch of categories. Each must have TreeID, ParentID and Name set.
+func (s *CategoryTreeServiceOp) CreateCategories(categories []TreeCategory) ([]TreeCategory, error) {
+	return s.CreateCategoriesWithContext(context.Background(), categories)
+}
+
+// CreateCategoriesWithContext is the context-aware variant of CreateCategories.
+func (s *CategoryTreeServiceOp) CreateCategoriesWithContext(ctx context.Context, categories []TreeCategory) ([]TreeCategory, error) {
+	listResult := ListTreeCategoryResponse{}
+	jsonBody, err := json.Marshal(categories)
+	if err != nil {
+		return listResult.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, "/v3/catalog/trees/categories", reqBody)
+	if reqErr != nil {
+		return listResult.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult.Data, jsonErr
+	}
+	return listResult.Data, nil
+}
+
+// UpdateCategories will update a batch of categories. Each must have CategoryID set.
+func (s *CategoryTreeServiceOp) UpdateCategories(categories []TreeCategory) error {
+	return s.UpdateCategoriesWithContext(context.Background(), categories)
+}
+
+// UpdateCategoriesWithContext is the context-aware variant of UpdateCategories.
+func (s *CategoryTreeServiceOp) UpdateCategoriesWithContext(ctx context.Context, categories []TreeCategory) error {
+	jsonBody, err := json.Marshal(categories)
+	if err != nil {
+		return err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, "/v3/catalog/trees/categories", reqBody)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// DeleteCategories will delete the categories with the provided IDs from their trees.
+func (s *CategoryTreeServiceOp) DeleteCategories(ids []int) error {
+	return s.DeleteCategoriesWithContext(context.Background(), ids)
+}
+
+// DeleteCategoriesWithContext is the context-aware variant of DeleteCategories.
+func (s *CategoryTreeServiceOp) DeleteCategoriesWithContext(ctx context.Context, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	path, err := addQuery("/v3/catalog/trees/categories", ListTreeCategoriesOptions{CategoryIDIn: ids})
+	if err != nil {
+		return err
+	}
+
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, path, nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// IterateCategories will walk every tree category matching the options, fetching further pages as needed.
+func (s *CategoryTreeServiceOp) IterateCategories(ctx context.Context, iteratorOptions IteratorOptions, options ...ListTreeCategoriesOptions) *Iterator {
+	var listOptions ListTreeCategoriesOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListCategoriesWithContext(ctx, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
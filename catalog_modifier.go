@@ -0,0 +1,351 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type ProductModifierService interface {
+	Get(int, int) (ProductModifier, error)
+	GetWithContext(context.Context, int, int) (ProductModifier, error)
+	List(int, ...ListOptions) (ListProductModifierResponse, error)
+	ListWithContext(context.Context, int, ...ListOptions) (ListProductModifierResponse, error)
+	Create(int, ProductModifier) (ProductModifier, error)
+	CreateWithContext(context.Context, int, ProductModifier) (ProductModifier, error)
+	Update(int, ProductModifier) (ProductModifier, error)
+	UpdateWithContext(context.Context, int, ProductModifier) (ProductModifier, error)
+	Delete(int, int) error
+	DeleteWithContext(context.Context, int, int) error
+	GetValue(int, int, int) (ModifierValue, error)
+	GetValueWithContext(context.Context, int, int, int) (ModifierValue, error)
+	ListValues(int, int, ...ListOptions) (ListModifierValueResponse, error)
+	ListValuesWithContext(context.Context, int, int, ...ListOptions) (ListModifierValueResponse, error)
+	CreateValue(int, int, ModifierValue) (ModifierValue, error)
+	CreateValueWithContext(context.Context, int, int, ModifierValue) (ModifierValue, error)
+	UpdateValue(int, int, ModifierValue) (ModifierValue, error)
+	UpdateValueWithContext(context.Context, int, int, ModifierValue) (ModifierValue, error)
+	DeleteValue(int, int, int) error
+	DeleteValueWithContext(context.Context, int, int, int) error
+	Iterate(context.Context, IteratorOptions, int, ...ListOptions) *Iterator
+}
+
+type GetProductModifierResponse struct {
+	Data ProductModifier `json:"data"`
+}
+
+type ListProductModifierResponse struct {
+	Data []ProductModifier `json:"data"`
+	Meta MetaResult        `json:"meta"`
+}
+
+type GetModifierValueResponse struct {
+	Data ModifierValue `json:"data"`
+}
+
+type ListModifierValueResponse struct {
+	Data []ModifierValue `json:"data"`
+	Meta MetaResult      `json:"meta"`
+}
+
+// ProductModifier structure.
+// Modifiers are options that don't create variants, such as a gift message or
+// an engraving, and may adjust the price or weight of the product.
+type ProductModifier struct {
+	ID           int             `json:"id,omitempty"`
+	ProductID    int             `json:"product_id,omitempty"`
+	Name         string          `json:"name,omitempty"`
+	DisplayName  string          `json:"display_name,omitempty"`
+	Type         string          `json:"type,omitempty"`
+	Required     *bool           `json:"required,omitempty"`
+	SortOrder    int             `json:"sort_order,omitempty"`
+	Config       *OptionConfig   `json:"config,omitempty"`
+	OptionValues []ModifierValue `json:"option_values,omitempty"`
+}
+
+// ModifierValue structure.
+type ModifierValue struct {
+	ID        int                    `json:"id,omitempty"`
+	OptionID  int                    `json:"option_id,omitempty"`
+	Label     string                 `json:"label,omitempty"`
+	SortOrder int                    `json:"sort_order,omitempty"`
+	IsDefault *bool                  `json:"is_default,omitempty"`
+	ValueData map[string]interface{} `json:"value_data,omitempty"`
+	Adjusters *ModifierAdjusters     `json:"adjusters,omitempty"`
+}
+
+// ModifierAdjusters describe how choosing a modifier value changes the product.
+type ModifierAdjusters struct {
+	Price              *Adjuster           `json:"price,omitempty"`
+	Weight             *Adjuster           `json:"weight,omitempty"`
+	ImageURL           string              `json:"image_url,omitempty"`
+	PurchasingDisabled *PurchasingDisabled `json:"purchasing_disabled,omitempty"`
+}
+
+// Adjuster changes a price or weight. Adjuster is "relative" or "percentage".
+type Adjuster struct {
+	Adjuster      string  `json:"adjuster"`
+	AdjusterValue float64 `json:"adjuster_value"`
+}
+
+// PurchasingDisabled stops a product from being purchased when a value is chosen.
+type PurchasingDisabled struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+type ProductModifierServiceOp struct {
+	client *Client
+}
+
+// Get will fetch a single modifier of a product.
+func (s *ProductModifierServiceOp) Get(productID, modifierID int) (ProductModifier, error) {
+	return s.GetWithContext(context.Background(), productID, modifierID)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *ProductModifierServiceOp) GetWithContext(ctx context.Context, productID, modifierID int) (ProductModifier, error) {
+	response := GetProductModifierResponse{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/catalog/products/%d/modifiers/%d", productID, modifierID), nil)
+	if reqErr != nil {
+		return response.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &response)
+	if jsonErr != nil {
+		return response.Data, jsonErr
+	}
+	return response.Data, nil
+}
+
+// List will return a page of a product's modifiers.
+func (s *ProductModifierServiceOp) List(productID int, options ...ListOptions) (ListProductModifierResponse, error) {
+	return s.ListWithContext(context.Background(), productID, options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *ProductModifierServiceOp) ListWithContext(ctx context.Context, productID int, options ...ListOptions) (ListProductModifierResponse, error) {
+	listResult := ListProductModifierResponse{}
+
+	var listOptions ListOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/catalog/products/%d/modifiers", productID), listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// Create will add a modifier to a product.
+func (s *ProductModifierServiceOp) Create(productID int, modifier ProductModifier) (ProductModifier, error) {
+	return s.CreateWithContext(context.Background(), productID, modifier)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *ProductModifierServiceOp) CreateWithContext(ctx context.Context, productID int, modifier ProductModifier) (ProductModifier, error) {
+	response := GetProductModifierResponse{}
+	jsonBody, err := json.Marshal(modifier)
+	if err != nil {
+		return response.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("/v3/catalog/products/%d/modifiers", productID), reqBody)
+	if reqErr != nil {
+		return response.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &response)
+	if jsonErr != nil {
+		return response.Data, jsonErr
+	}
+	return response.Data, nil
+}
+
+// Update will update a single modifier of a product.
+func (s *ProductModifierServiceOp) Update(productID int, modifier ProductModifier) (ProductModifier, error) {
+	return s.UpdateWithContext(context.Background(), productID, modifier)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *ProductModifierServiceOp) UpdateWithContext(ctx context.Context, productID int, modifier ProductModifier) (ProductModifier, error) {
+	response := GetProductModifierResponse{}
+	jsonBody, err := json.Marshal(modifier)
+	if err != nil {
+		return response.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/catalog/products/%d/modifiers/%d", productID, modifier.ID), reqBody)
+	if reqErr != nil {
+		return response.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &response)
+	if jsonErr != nil {
+		return response.Data, jsonErr
+	}
+	return response.Data, nil
+}
+
+// Delete will remove a modifier from a product.
+func (s *ProductModifierServiceOp) Delete(productID, modifierID int) error {
+	return s.DeleteWithContext(context.Background(), productID, modifierID)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *ProductModifierServiceOp) DeleteWithContext(ctx context.Context, productID, modifierID int) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/catalog/products/%d/modifiers/%d", productID, modifierID), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// GetValue will fetch a single value of a modifier.
+func (s *ProductModifierServiceOp) GetValue(productID, modifierID, valueID int) (ModifierValue, error) {
+	return s.GetValueWithContext(context.Background(), productID, modifierID, valueID)
+}
+
+// GetValueWithContext is the context-aware variant of GetValue.
+func (s *ProductModifierServiceOp) GetValueWithContext(ctx context.Context, productID, modifierID, valueID int) (ModifierValue, error) {
+	valueResponse := GetModifierValueResponse{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/catalog/products/%d/modifiers/%d/values/%d", productID, modifierID, valueID), nil)
+	if reqErr != nil {
+		return valueResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &valueResponse)
+	if jsonErr != nil {
+		return valueResponse.Data, jsonErr
+	}
+	return valueResponse.Data, nil
+}
+
+// ListValues will return a page of the values of a modifier.
+func (s *ProductModifierServiceOp) ListValues(productID, modifierID int, options ...ListOptions) (ListModifierValueResponse, error) {
+	return s.ListValuesWithContext(context.Background(), productID, modifierID, options...)
+}
+
+// ListValuesWithContext is the context-aware variant of ListValues.
+func (s *ProductModifierServiceOp) ListValuesWithContext(ctx context.Context, productID, modifierID int, options ...ListOptions) (ListModifierValueResponse, error) {
+	listResult := ListModifierValueResponse{}
+
+	var listOptions ListOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/catalog/products/%d/modifiers/%d/values", productID, modifierID), listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// CreateValue will add a value to a modifier.
+func (s *ProductModifierServiceOp) CreateValue(productID, modifierID int, value ModifierValue) (ModifierValue, error) {
+	return s.CreateValueWithContext(context.Background(), productID, modifierID, value)
+}
+
+// CreateValueWithContext is the context-aware variant of CreateValue.
+func (s *ProductModifierServiceOp) CreateValueWithContext(ctx context.Context, productID, modifierID int, value ModifierValue) (ModifierValue, error) {
+	valueResponse := GetModifierValueResponse{}
+	jsonBody, err := json.Marshal(value)
+	if err != nil {
+		return valueResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("/v3/catalog/products/%d/modifiers/%d/values", productID, modifierID), reqBody)
+	if reqErr != nil {
+		return valueResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &valueResponse)
+	if jsonErr != nil {
+		return valueResponse.Data, jsonErr
+	}
+	return valueResponse.Data, nil
+}
+
+// UpdateValue will update a single value of a modifier.
+func (s *ProductModifierServiceOp) UpdateValue(productID, modifierID int, value ModifierValue) (ModifierValue, error) {
+	return s.UpdateValueWithContext(context.Background(), productID, modifierID, value)
+}
+
+// UpdateValueWithContext is the context-aware variant of UpdateValue.
+func (s *ProductModifierServiceOp) UpdateValueWithContext(ctx context.Context, productID, modifierID int, value ModifierValue) (ModifierValue, error) {
+	valueResponse := GetModifierValueResponse{}
+	jsonBody, err := json.Marshal(value)
+	if err != nil {
+		return valueResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/catalog/products/%d/modifiers/%d/values/%d", productID, modifierID, value.ID), reqBody)
+	if reqErr != nil {
+		return valueResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &valueResponse)
+	if jsonErr != nil {
+		return valueResponse.Data, jsonErr
+	}
+	return valueResponse.Data, nil
+}
+
+// DeleteValue will remove a value from a modifier.
+func (s *ProductModifierServiceOp) DeleteValue(productID, modifierID, valueID int) error {
+	return s.DeleteValueWithContext(context.Background(), productID, modifierID, valueID)
+}
+
+// DeleteValueWithContext is the context-aware variant of DeleteValue.
+func (s *ProductModifierServiceOp) DeleteValueWithContext(ctx context.Context, productID, modifierID, valueID int) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/catalog/products/%d/modifiers/%d/values/%d", productID, modifierID, valueID), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// Iterate will walk every modifier of a product, fetching further pages as needed.
+func (s *ProductModifierServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, productID int, options ...ListOptions) *Iterator {
+	var listOptions ListOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListWithContext(ctx, productID, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
@@ -0,0 +1,359 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type ProductOptionService interface {
+	Get(int, int) (ProductOption, error)
+	GetWithContext(context.Context, int, int) (ProductOption, error)
+	List(int, ...ListOptions) (ListProductOptionResponse, error)
+	ListWithContext(context.Context, int, ...ListOptions) (ListProductOptionResponse, error)
+	Create(int, ProductOption) (ProductOption, error)
+	CreateWithContext(context.Context, int, ProductOption) (ProductOption, error)
+	Update(int, ProductOption) (ProductOption, error)
+	UpdateWithContext(context.Context, int, ProductOption) (ProductOption, error)
+	Delete(int, int) error
+	DeleteWithContext(context.Context, int, int) error
+	GetValue(int, int, int) (ProductOptionValue, error)
+	GetValueWithContext(context.Context, int, int, int) (ProductOptionValue, error)
+	ListValues(int, int, ...ListOptions) (ListProductOptionValueResponse, error)
+	ListValuesWithContext(context.Context, int, int, ...ListOptions) (ListProductOptionValueResponse, error)
+	CreateValue(int, int, ProductOptionValue) (ProductOptionValue, error)
+	CreateValueWithContext(context.Context, int, int, ProductOptionValue) (ProductOptionValue, error)
+	UpdateValue(int, int, ProductOptionValue) (ProductOptionValue, error)
+	UpdateValueWithContext(context.Context, int, int, ProductOptionValue) (ProductOptionValue, error)
+	DeleteValue(int, int, int) error
+	DeleteValueWithContext(context.Context, int, int, int) error
+	Iterate(context.Context, IteratorOptions, int, ...ListOptions) *Iterator
+}
+
+type GetProductOptionResponse struct {
+	Data ProductOption `json:"data"`
+}
+
+type ListProductOptionResponse struct {
+	Data []ProductOption `json:"data"`
+	Meta MetaResult      `json:"meta"`
+}
+
+type GetProductOptionValueResponse struct {
+	Data ProductOptionValue `json:"data"`
+}
+
+type ListProductOptionValueResponse struct {
+	Data []ProductOptionValue `json:"data"`
+	Meta MetaResult           `json:"meta"`
+}
+
+// ProductOption structure.
+// Options are the choices (size, colour...) that variants are built from.
+// Type is one of "radio_buttons", "rectangles", "dropdown", "product_list",
+// "product_list_with_images" or "swatch".
+type ProductOption struct {
+	ID           int                  `json:"id,omitempty"`
+	ProductID    int                  `json:"product_id,omitempty"`
+	Name         string               `json:"name,omitempty"`
+	DisplayName  string               `json:"display_name,omitempty"`
+	Type         string               `json:"type,omitempty"`
+	Config       *OptionConfig        `json:"config,omitempty"`
+	SortOrder    int                  `json:"sort_order,omitempty"`
+	OptionValues []ProductOptionValue `json:"option_values,omitempty"`
+}
+
+// ProductOptionValue structure.
+// ValueData holds type specific data, such as the colors of a swatch.
+type ProductOptionValue struct {
+	ID        int                    `json:"id,omitempty"`
+	Label     string                 `json:"label,omitempty"`
+	SortOrder int                    `json:"sort_order,omitempty"`
+	IsDefault *bool                  `json:"is_default,omitempty"`
+	ValueData map[string]interface{} `json:"value_data,omitempty"`
+}
+
+// OptionConfig holds the type specific configuration of options and modifiers.
+// Only the fields relevant to the option's type are set.
+type OptionConfig struct {
+	DefaultValue                string   `json:"default_value,omitempty"`
+	CheckedByDefault            *bool    `json:"checked_by_default,omitempty"`
+	CheckboxLabel               string   `json:"checkbox_label,omitempty"`
+	DateLimited                 *bool    `json:"date_limited,omitempty"`
+	DateLimitMode               string   `json:"date_limit_mode,omitempty"`
+	DateEarliestValue           string   `json:"date_earliest_value,omitempty"`
+	DateLatestValue             string   `json:"date_latest_value,omitempty"`
+	FileTypesMode               string   `json:"file_types_mode,omitempty"`
+	FileTypesSupported          []string `json:"file_types_supported,omitempty"`
+	FileTypesOther              []string `json:"file_types_other,omitempty"`
+	FileMaxSize                 int      `json:"file_max_size,omitempty"`
+	TextCharactersLimited       *bool    `json:"text_characters_limited,omitempty"`
+	TextMinLength               int      `json:"text_min_length,omitempty"`
+	TextMaxLength               int      `json:"text_max_length,omitempty"`
+	TextLinesLimited            *bool    `json:"text_lines_limited,omitempty"`
+	TextMaxLines                int      `json:"text_max_lines,omitempty"`
+	NumberLimited               *bool    `json:"number_limited,omitempty"`
+	NumberLimitMode             string   `json:"number_limit_mode,omitempty"`
+	NumberLowestValue           float64  `json:"number_lowest_value,omitempty"`
+	NumberHighestValue          float64  `json:"number_highest_value,omitempty"`
+	NumberIntegersOnly          *bool    `json:"number_integers_only,omitempty"`
+	ProductListAdjustsInventory *bool    `json:"product_list_adjusts_inventory,omitempty"`
+	ProductListAdjustsPricing   *bool    `json:"product_list_adjusts_pricing,omitempty"`
+	ProductListShippingCalc     string   `json:"product_list_shipping_calc,omitempty"`
+}
+
+type ProductOptionServiceOp struct {
+	client *Client
+}
+
+// Get will fetch a single option of a product.
+func (s *ProductOptionServiceOp) Get(productID, optionID int) (ProductOption, error) {
+	return s.GetWithContext(context.Background(), productID, optionID)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *ProductOptionServiceOp) GetWithContext(ctx context.Context, productID, optionID int) (ProductOption, error) {
+	response := GetProductOptionResponse{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/catalog/products/%d/options/%d", productID, optionID), nil)
+	if reqErr != nil {
+		return response.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &response)
+	if jsonErr != nil {
+		return response.Data, jsonErr
+	}
+	return response.Data, nil
+}
+
+// List will return a page of a product's options.
+func (s *ProductOptionServiceOp) List(productID int, options ...ListOptions) (ListProductOptionResponse, error) {
+	return s.ListWithContext(context.Background(), productID, options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *ProductOptionServiceOp) ListWithContext(ctx context.Context, productID int, options ...ListOptions) (ListProductOptionResponse, error) {
+	listResult := ListProductOptionResponse{}
+
+	var listOptions ListOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/catalog/products/%d/options", productID), listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// Create will add a option to a product.
+func (s *ProductOptionServiceOp) Create(productID int, option ProductOption) (ProductOption, error) {
+	return s.CreateWithContext(context.Background(), productID, option)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *ProductOptionServiceOp) CreateWithContext(ctx context.Context, productID int, option ProductOption) (ProductOption, error) {
+	response := GetProductOptionResponse{}
+	jsonBody, err := json.Marshal(option)
+	if err != nil {
+		return response.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("/v3/catalog/products/%d/options", productID), reqBody)
+	if reqErr != nil {
+		return response.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &response)
+	if jsonErr != nil {
+		return response.Data, jsonErr
+	}
+	return response.Data, nil
+}
+
+// Update will update a single option of a product.
+func (s *ProductOptionServiceOp) Update(productID int, option ProductOption) (ProductOption, error) {
+	return s.UpdateWithContext(context.Background(), productID, option)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *ProductOptionServiceOp) UpdateWithContext(ctx context.Context, productID int, option ProductOption) (ProductOption, error) {
+	response := GetProductOptionResponse{}
+	jsonBody, err := json.Marshal(option)
+	if err != nil {
+		return response.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/catalog/products/%d/options/%d", productID, option.ID), reqBody)
+	if reqErr != nil {
+		return response.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &response)
+	if jsonErr != nil {
+		return response.Data, jsonErr
+	}
+	return response.Data, nil
+}
+
+// Delete will remove a option from a product.
+func (s *ProductOptionServiceOp) Delete(productID, optionID int) error {
+	return s.DeleteWithContext(context.Background(), productID, optionID)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *ProductOptionServiceOp) DeleteWithContext(ctx context.Context, productID, optionID int) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/catalog/products/%d/options/%d", productID, optionID), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// GetValue will fetch a single value of a option.
+func (s *ProductOptionServiceOp) GetValue(productID, optionID, valueID int) (ProductOptionValue, error) {
+	return s.GetValueWithContext(context.Background(), productID, optionID, valueID)
+}
+
+// GetValueWithContext is the context-aware variant of GetValue.
+func (s *ProductOptionServiceOp) GetValueWithContext(ctx context.Context, productID, optionID, valueID int) (ProductOptionValue, error) {
+	valueResponse := GetProductOptionValueResponse{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/catalog/products/%d/options/%d/values/%d", productID, optionID, valueID), nil)
+	if reqErr != nil {
+		return valueResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &valueResponse)
+	if jsonErr != nil {
+		return valueResponse.Data, jsonErr
+	}
+	return valueResponse.Data, nil
+}
+
+// ListValues will return a page of the values of a option.
+func (s *ProductOptionServiceOp) ListValues(productID, optionID int, options ...ListOptions) (ListProductOptionValueResponse, error) {
+	return s.ListValuesWithContext(context.Background(), productID, optionID, options...)
+}
+
+// ListValuesWithContext is the context-aware variant of ListValues.
+func (s *ProductOptionServiceOp) ListValuesWithContext(ctx context.Context, productID, optionID int, options ...ListOptions) (ListProductOptionValueResponse, error) {
+	listResult := ListProductOptionValueResponse{}
+
+	var listOptions ListOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/catalog/products/%d/options/%d/values", productID, optionID), listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// CreateValue will add a value to a option.
+func (s *ProductOptionServiceOp) CreateValue(productID, optionID int, value ProductOptionValue) (ProductOptionValue, error) {
+	return s.CreateValueWithContext(context.Background(), productID, optionID, value)
+}
+
+// CreateValueWithContext is the context-aware variant of CreateValue.
+func (s *ProductOptionServiceOp) CreateValueWithContext(ctx context.Context, productID, optionID int, value ProductOptionValue) (ProductOptionValue, error) {
+	valueResponse := GetProductOptionValueResponse{}
+	jsonBody, err := json.Marshal(value)
+	if err != nil {
+		return valueResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("/v3/catalog/products/%d/options/%d/values", productID, optionID), reqBody)
+	if reqErr != nil {
+		return valueResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &valueResponse)
+	if jsonErr != nil {
+		return valueResponse.Data, jsonErr
+	}
+	return valueResponse.Data, nil
+}
+
+// UpdateValue will update a single value of a option.
+func (s *ProductOptionServiceOp) UpdateValue(productID, optionID int, value ProductOptionValue) (ProductOptionValue, error) {
+	return s.UpdateValueWithContext(context.Background(), productID, optionID, value)
+}
+
+// UpdateValueWithContext is the context-aware variant of UpdateValue.
+func (s *ProductOptionServiceOp) UpdateValueWithContext(ctx context.Context, productID, optionID int, value ProductOptionValue) (ProductOptionValue, error) {
+	valueResponse := GetProductOptionValueResponse{}
+	jsonBody, err := json.Marshal(value)
+	if err != nil {
+		return valueResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/catalog/products/%d/options/%d/values/%d", productID, optionID, value.ID), reqBody)
+	if reqErr != nil {
+		return valueResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &valueResponse)
+	if jsonErr != nil {
+		return valueResponse.Data, jsonErr
+	}
+	return valueResponse.Data, nil
+}
+
+// DeleteValue will remove a value from a option.
+func (s *ProductOptionServiceOp) DeleteValue(productID, optionID, valueID int) error {
+	return s.DeleteValueWithContext(context.Background(), productID, optionID, valueID)
+}
+
+// DeleteValueWithContext is the context-aware variant of DeleteValue.
+func (s *ProductOptionServiceOp) DeleteValueWithContext(ctx context.Context, productID, optionID, valueID int) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/catalog/products/%d/options/%d/values/%d", productID, optionID, valueID), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// Iterate will walk every option of a product, fetching further pages as needed.
+func (s *ProductOptionServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, productID int, options ...ListOptions) *Iterator {
+	var listOptions ListOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListWithContext(ctx, productID, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
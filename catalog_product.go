@@ -0,0 +1,434 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// MaxProductBatchSize is the number of products the API accepts in a single batch update.
+const MaxProductBatchSize = 10
+
+// Values accepted by the include parameter of the products endpoints.
+const (
+	ProductIncludeVariants         = "variants"
+	ProductIncludeImages           = "images"
+	ProductIncludeCustomFields     = "custom_fields"
+	ProductIncludeBulkPricingRules = "bulk_pricing_rules"
+	ProductIncludePrimaryImage     = "primary_image"
+	ProductIncludeModifiers        = "modifiers"
+	ProductIncludeOptions          = "options"
+	ProductIncludeVideos           = "videos"
+)
+
+type ProductService interface {
+	Get(int, ...GetProductOptions) (Product, error)
+	GetWithContext(context.Context, int, ...GetProductOptions) (Product, error)
+	List(...ListProductsOptions) (ListProductResponse, error)
+	ListWithContext(context.Context, ...ListProductsOptions) (ListProductResponse, error)
+	Create(Product) (Product, error)
+	CreateWithContext(context.Context, Product) (Product, error)
+	Update(Product) (Product, error)
+	UpdateWithContext(context.Context, Product) (Product, error)
+	UpdateBatch([]Product) ([]Product, error)
+	UpdateBatchWithContext(context.Context, []Product) ([]Product, error)
+	Delete(int) error
+	DeleteWithContext(context.Context, int) error
+	DeleteMany([]int) error
+	DeleteManyWithContext(context.Context, []int) error
+	Iterate(context.Context, IteratorOptions, ...ListProductsOptions) *Iterator
+}
+
+type GetProductResponse struct {
+	Data Product `json:"data"`
+}
+
+type ListProductResponse struct {
+	Data []Product  `json:"data"`
+	Meta MetaResult `json:"meta"`
+}
+
+// CustomURL is the storefront URL of a catalog entity.
+type CustomURL struct {
+	URL          string `json:"url,omitempty"`
+	IsCustomized *bool  `json:"is_customized,omitempty"`
+}
+
+// Product structure.
+// Optional booleans, prices and dimensions are pointers so that partial
+// updates don't reset them; use Bool and Float64 to set them.
+type Product struct {
+	ID          int      `json:"id,omitempty"`
+	Name        string   `json:"name,omitempty"`
+	Type        string   `json:"type,omitempty"`
+	SKU         string   `json:"sku,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Weight      *float64 `json:"weight,omitempty"`
+	Width       *float64 `json:"width,omitempty"`
+	Depth       *float64 `json:"depth,omitempty"`
+	Height      *float64 `json:"height,omitempty"`
+	Price       *float64 `json:"price,omitempty"`
+	CostPrice   *float64 `json:"cost_price,omitempty"`
+	RetailPrice *float64 `json:"retail_price,omitempty"`
+	SalePrice   *float64 `json:"sale_price,omitempty"`
+	MapPrice    *float64 `json:"map_price,omitempty"`
+	// CalculatedPrice is read-only; it is computed from the price and any sale price.
+	CalculatedPrice             float64              `json:"calculated_price,omitempty"`
+	TaxClassID                  int                  `json:"tax_class_id,omitempty"`
+	ProductTaxCode              string               `json:"product_tax_code,omitempty"`
+	Categories                  []int                `json:"categories,omitempty"`
+	BrandID                     int                  `json:"brand_id,omitempty"`
+	OptionSetID                 int                  `json:"option_set_id,omitempty"`
+	OptionSetDisplay            string               `json:"option_set_display,omitempty"`
+	InventoryLevel              *int                 `json:"inventory_level,omitempty"`
+	InventoryWarningLevel       *int                 `json:"inventory_warning_level,omitempty"`
+	InventoryTracking           string               `json:"inventory_tracking,omitempty"`
+	ReviewsRatingSum            int                  `json:"reviews_rating_sum,omitempty"`
+	ReviewsCount                int                  `json:"reviews_count,omitempty"`
+	TotalSold                   int                  `json:"total_sold,omitempty"`
+	FixedCostShippingPrice      *float64             `json:"fixed_cost_shipping_price,omitempty"`
+	IsFreeShipping              *bool                `json:"is_free_shipping,omitempty"`
+	IsVisible                   *bool                `json:"is_visible,omitempty"`
+	IsFeatured                  *bool                `json:"is_featured,omitempty"`
+	RelatedProducts             []int                `json:"related_products,omitempty"`
+	Warranty                    string               `json:"warranty,omitempty"`
+	BinPickingNumber            string               `json:"bin_picking_number,omitempty"`
+	LayoutFile                  string               `json:"layout_file,omitempty"`
+	UPC                         string               `json:"upc,omitempty"`
+	MPN                         string               `json:"mpn,omitempty"`
+	GTIN                        string               `json:"gtin,omitempty"`
+	SearchKeywords              string               `json:"search_keywords,omitempty"`
+	Availability                string               `json:"availability,omitempty"`
+	AvailabilityDescription     string               `json:"availability_description,omitempty"`
+	GiftWrappingOptionsType     string               `json:"gift_wrapping_options_type,omitempty"`
+	GiftWrappingOptionsList     []int                `json:"gift_wrapping_options_list,omitempty"`
+	SortOrder                   int                  `json:"sort_order,omitempty"`
+	Condition                   string               `json:"condition,omitempty"`
+	IsConditionShown            *bool                `json:"is_condition_shown,omitempty"`
+	OrderQuantityMinimum        int                  `json:"order_quantity_minimum,omitempty"`
+	OrderQuantityMaximum        int                  `json:"order_quantity_maximum,omitempty"`
+	PageTitle                   string               `json:"page_title,omitempty"`
+	MetaKeywords                []string             `json:"meta_keywords,omitempty"`
+	MetaDescription             string               `json:"meta_description,omitempty"`
+	ViewCount                   int                  `json:"view_count,omitempty"`
+	PreorderReleaseDate         string               `json:"preorder_release_date,omitempty"`
+	PreorderMessage             string               `json:"preorder_message,omitempty"`
+	IsPreorderOnly              *bool                `json:"is_preorder_only,omitempty"`
+	IsPriceHidden               *bool                `json:"is_price_hidden,omitempty"`
+	PriceHiddenLabel            string               `json:"price_hidden_label,omitempty"`
+	CustomURL                   *CustomURL           `json:"custom_url,omitempty"`
+	BaseVariantID               int                  `json:"base_variant_id,omitempty"`
+	OpenGraphType               string               `json:"open_graph_type,omitempty"`
+	OpenGraphTitle              string               `json:"open_graph_title,omitempty"`
+	OpenGraphDescription        string               `json:"open_graph_description,omitempty"`
+	OpenGraphUseMetaDescription *bool                `json:"open_graph_use_meta_description,omitempty"`
+	OpenGraphUseProductName     *bool                `json:"open_graph_use_product_name,omitempty"`
+	OpenGraphUseImage           *bool                `json:"open_graph_use_image,omitempty"`
+	DateCreated                 string               `json:"date_created,omitempty"`
+	DateModified                string               `json:"date_modified,omitempty"`
+	Variants                    []Variant            `json:"variants,omitempty"`
+	Images                      []ProductImage       `json:"images,omitempty"`
+	PrimaryImage                *ProductImage        `json:"primary_image,omitempty"`
+	Videos                      []ProductVideo       `json:"videos,omitempty"`
+	CustomFields                []ProductCustomField `json:"custom_fields,omitempty"`
+	BulkPricingRules            []BulkPricingRule    `json:"bulk_pricing_rules,omitempty"`
+	Options                     []ProductOption      `json:"options,omitempty"`
+	Modifiers                   []ProductModifier    `json:"modifiers,omitempty"`
+}
+
+// ProductImage structure.
+type ProductImage struct {
+	ID           int    `json:"id,omitempty"`
+	ProductID    int    `json:"product_id,omitempty"`
+	IsThumbnail  *bool  `json:"is_thumbnail,omitempty"`
+	SortOrder    int    `json:"sort_order,omitempty"`
+	Description  string `json:"description,omitempty"`
+	ImageURL     string `json:"image_url,omitempty"`
+	URLZoom      string `json:"url_zoom,omitempty"`
+	URLStandard  string `json:"url_standard,omitempty"`
+	URLThumbnail string `json:"url_thumbnail,omitempty"`
+	URLTiny      string `json:"url_tiny,omitempty"`
+	DateModified string `json:"date_modified,omitempty"`
+}
+
+// ProductVideo structure.
+type ProductVideo struct {
+	ID          int    `json:"id,omitempty"`
+	ProductID   int    `json:"product_id,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	SortOrder   int    `json:"sort_order,omitempty"`
+	Type        string `json:"type,omitempty"`
+	VideoID     string `json:"video_id,omitempty"`
+	Length      string `json:"length,omitempty"`
+}
+
+// ProductCustomField structure.
+type ProductCustomField struct {
+	ID    int    `json:"id,omitempty"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// BulkPricingRule structure.
+// Type is one of "price", "percent" or "fixed".
+type BulkPricingRule struct {
+	ID          int     `json:"id,omitempty"`
+	QuantityMin int     `json:"quantity_min"`
+	QuantityMax int     `json:"quantity_max"`
+	Type        string  `json:"type"`
+	Amount      float64 `json:"amount"`
+}
+
+// GetProductOptions controls the sub-resources and fields returned with a product.
+type GetProductOptions struct {
+	Include       []string `url:"include,omitempty"`
+	IncludeFields []string `url:"include_fields,omitempty"`
+	ExcludeFields []string `url:"exclude_fields,omitempty"`
+}
+
+// ListProductsOptions filters the products returned by List.
+type ListProductsOptions struct {
+	ListOptions
+	GetProductOptions
+	ID               int       `url:"id,omitempty"`
+	IDIn             []int     `url:"id:in,omitempty"`
+	IDNotIn          []int     `url:"id:not_in,omitempty"`
+	IDMin            int       `url:"id:min,omitempty"`
+	IDMax            int       `url:"id:max,omitempty"`
+	Name             string    `url:"name,omitempty"`
+	NameLike         string    `url:"name:like,omitempty"`
+	SKU              string    `url:"sku,omitempty"`
+	SKUIn            []string  `url:"sku:in,omitempty"`
+	UPC              string    `url:"upc,omitempty"`
+	Price            float64   `url:"price,omitempty"`
+	Weight           float64   `url:"weight,omitempty"`
+	Condition        string    `url:"condition,omitempty"`
+	BrandID          int       `url:"brand_id,omitempty"`
+	Type             string    `url:"type,omitempty"`
+	Availability     string    `url:"availability,omitempty"`
+	Categories       int       `url:"categories,omitempty"`
+	CategoriesIn     []int     `url:"categories:in,omitempty"`
+	Keyword          string    `url:"keyword,omitempty"`
+	KeywordContext   string    `url:"keyword_context,omitempty"`
+	IsVisible        *bool     `url:"is_visible,omitempty"`
+	IsFeatured       *bool     `url:"is_featured,omitempty"`
+	IsFreeShipping   *bool     `url:"is_free_shipping,omitempty"`
+	InventoryLevel   *int      `url:"inventory_level,omitempty"`
+	InventoryLow     *int      `url:"inventory_low,omitempty"`
+	OutOfStock       *int      `url:"out_of_stock,omitempty"`
+	TotalSold        *int      `url:"total_sold,omitempty"`
+	DateModified     time.Time `url:"date_modified,omitempty"`
+	DateModifiedMin  time.Time `url:"date_modified:min,omitempty"`
+	DateModifiedMax  time.Time `url:"date_modified:max,omitempty"`
+	DateLastImported time.Time `url:"date_last_imported,omitempty"`
+	Sort             string    `url:"sort,omitempty"`
+	Direction        string    `url:"direction,omitempty"`
+}
+
+type ProductServiceOp struct {
+	client *Client
+}
+
+// Get will fetch a single product by the provided ID.
+func (s *ProductServiceOp) Get(id int, options ...GetProductOptions) (Product, error) {
+	return s.GetWithContext(context.Background(), id, options...)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *ProductServiceOp) GetWithContext(ctx context.Context, id int, options ...GetProductOptions) (Product, error) {
+	productResponse := GetProductResponse{}
+
+	var getOptions GetProductOptions
+	if len(options) > 0 {
+		getOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/catalog/products/%d", id), getOptions)
+	if err != nil {
+		return productResponse.Data, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return productResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &productResponse)
+	if jsonErr != nil {
+		return productResponse.Data, jsonErr
+	}
+	return productResponse.Data, nil
+}
+
+// List will return a page of products matching the options.
+func (s *ProductServiceOp) List(options ...ListProductsOptions) (ListProductResponse, error) {
+	return s.ListWithContext(context.Background(), options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *ProductServiceOp) ListWithContext(ctx context.Context, options ...ListProductsOptions) (ListProductResponse, error) {
+	listResult := ListProductResponse{}
+
+	var listOptions ListProductsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/catalog/products", listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// Create will create a new product.
+// The fields required on a product are: Name, Type, Weight and Price.
+func (s *ProductServiceOp) Create(product Product) (Product, error) {
+	return s.CreateWithContext(context.Background(), product)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *ProductServiceOp) CreateWithContext(ctx context.Context, product Product) (Product, error) {
+	productResponse := GetProductResponse{}
+	jsonBody, err := json.Marshal(product)
+	if err != nil {
+		return productResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, "/v3/catalog/products", reqBody)
+	if reqErr != nil {
+		return productResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &productResponse)
+	if jsonErr != nil {
+		return productResponse.Data, jsonErr
+	}
+	return productResponse.Data, nil
+}
+
+// Update will update a single product. Only the fields that are set are changed.
+func (s *ProductServiceOp) Update(product Product) (Product, error) {
+	return s.UpdateWithContext(context.Background(), product)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *ProductServiceOp) UpdateWithContext(ctx context.Context, product Product) (Product, error) {
+	productResponse := GetProductResponse{}
+	jsonBody, err := json.Marshal(product)
+	if err != nil {
+		return productResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/catalog/products/%d", product.ID), reqBody)
+	if reqErr != nil {
+		return productResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &productResponse)
+	if jsonErr != nil {
+		return productResponse.Data, jsonErr
+	}
+	return productResponse.Data, nil
+}
+
+// UpdateBatch will update up to MaxProductBatchSize products in a single request.
+// Every product must have its ID set.
+func (s *ProductServiceOp) UpdateBatch(products []Product) ([]Product, error) {
+	return s.UpdateBatchWithContext(context.Background(), products)
+}
+
+// UpdateBatchWithContext is the context-aware variant of UpdateBatch.
+func (s *ProductServiceOp) UpdateBatchWithContext(ctx context.Context, products []Product) ([]Product, error) {
+	listResult := ListProductResponse{}
+	if len(products) > MaxProductBatchSize {
+		return listResult.Data, fmt.Errorf("bigcommerce: cannot update %d products in one batch, the limit is %d", len(products), MaxProductBatchSize)
+	}
+
+	jsonBody, err := json.Marshal(products)
+	if err != nil {
+		return listResult.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, "/v3/catalog/products", reqBody)
+	if reqErr != nil {
+		return listResult.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult.Data, jsonErr
+	}
+	return listResult.Data, nil
+}
+
+// Delete will delete a product by the provided ID.
+func (s *ProductServiceOp) Delete(id int) error {
+	return s.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *ProductServiceOp) DeleteWithContext(ctx context.Context, id int) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/catalog/products/%d", id), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// DeleteMany will delete every product with one of the provided IDs.
+func (s *ProductServiceOp) DeleteMany(ids []int) error {
+	return s.DeleteManyWithContext(context.Background(), ids)
+}
+
+// DeleteManyWithContext is the context-aware variant of DeleteMany.
+func (s *ProductServiceOp) DeleteManyWithContext(ctx context.Context, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	path, err := addQuery("/v3/catalog/products", ListProductsOptions{IDIn: ids})
+	if err != nil {
+		return err
+	}
+
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, path, nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// Iterate will walk every product matching the options, fetching further pages as needed.
+func (s *ProductServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, options ...ListProductsOptions) *Iterator {
+	var listOptions ListProductsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListWithContext(ctx, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
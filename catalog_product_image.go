@@ -0,0 +1,200 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+type ProductImageService interface {
+	Get(int, int) (ProductImage, error)
+	GetWithContext(context.Context, int, int) (ProductImage, error)
+	List(int, ...ListOptions) (ListProductImageResponse, error)
+	ListWithContext(context.Context, int, ...ListOptions) (ListProductImageResponse, error)
+	Create(int, ProductImage, ImageUpload) (ProductImage, error)
+	CreateWithContext(context.Context, int, ProductImage, ImageUpload) (ProductImage, error)
+	Update(int, ProductImage) (ProductImage, error)
+	UpdateWithContext(context.Context, int, ProductImage) (ProductImage, error)
+	Delete(int, int) error
+	DeleteWithContext(context.Context, int, int) error
+	Iterate(context.Context, IteratorOptions, int, ...ListOptions) *Iterator
+}
+
+type GetProductImageResponse struct {
+	Data ProductImage `json:"data"`
+}
+
+type ListProductImageResponse struct {
+	Data []ProductImage `json:"data"`
+	Meta MetaResult     `json:"meta"`
+}
+
+type ProductImageServiceOp struct {
+	client *Client
+}
+
+// formFields returns the image details sent alongside an uploaded file.
+func (i ProductImage) formFields() map[string]string {
+	fields := map[string]string{}
+	if i.Description != "" {
+		fields["description"] = i.Description
+	}
+	if i.IsThumbnail != nil {
+		fields["is_thumbnail"] = strconv.FormatBool(*i.IsThumbnail)
+	}
+	if i.SortOrder != 0 {
+		fields["sort_order"] = strconv.Itoa(i.SortOrder)
+	}
+	return fields
+}
+
+// Get will fetch a single image of a product.
+func (s *ProductImageServiceOp) Get(productID, imageID int) (ProductImage, error) {
+	return s.GetWithContext(context.Background(), productID, imageID)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *ProductImageServiceOp) GetWithContext(ctx context.Context, productID, imageID int) (ProductImage, error) {
+	imageResponse := GetProductImageResponse{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/catalog/products/%d/images/%d", productID, imageID), nil)
+	if reqErr != nil {
+		return imageResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &imageResponse)
+	if jsonErr != nil {
+		return imageResponse.Data, jsonErr
+	}
+	return imageResponse.Data, nil
+}
+
+// List will return a page of a product's images.
+func (s *ProductImageServiceOp) List(productID int, options ...ListOptions) (ListProductImageResponse, error) {
+	return s.ListWithContext(context.Background(), productID, options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *ProductImageServiceOp) ListWithContext(ctx context.Context, productID int, options ...ListOptions) (ListProductImageResponse, error) {
+	listResult := ListProductImageResponse{}
+
+	var listOptions ListOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/catalog/products/%d/images", productID), listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// Create will add an image to a product from upload, which may be a file or a URL.
+// Description, IsThumbnail and SortOrder are taken from image.
+func (s *ProductImageServiceOp) Create(productID int, image ProductImage, upload ImageUpload) (ProductImage, error) {
+	return s.CreateWithContext(context.Background(), productID, image, upload)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *ProductImageServiceOp) CreateWithContext(ctx context.Context, productID int, image ProductImage, upload ImageUpload) (ProductImage, error) {
+	imageResponse := GetProductImageResponse{}
+	path := fmt.Sprintf("/v3/catalog/products/%d/images", productID)
+
+	if err := upload.validate(); err != nil {
+		return imageResponse.Data, err
+	}
+
+	var body []byte
+	var reqErr error
+	if upload.isFile() {
+		body, reqErr = s.client.uploadImage(ctx, http.MethodPost, path, upload, image.formFields())
+	} else {
+		image.ImageURL = upload.ImageURL
+		jsonBody, err := json.Marshal(image)
+		if err != nil {
+			return imageResponse.Data, err
+		}
+		body, reqErr = s.client.DoRequestWithContext(ctx, http.MethodPost, path, bytes.NewReader(jsonBody))
+	}
+	if reqErr != nil {
+		return imageResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &imageResponse)
+	if jsonErr != nil {
+		return imageResponse.Data, jsonErr
+	}
+	return imageResponse.Data, nil
+}
+
+// Update will update the details of a single product image.
+func (s *ProductImageServiceOp) Update(productID int, image ProductImage) (ProductImage, error) {
+	return s.UpdateWithContext(context.Background(), productID, image)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *ProductImageServiceOp) UpdateWithContext(ctx context.Context, productID int, image ProductImage) (ProductImage, error) {
+	imageResponse := GetProductImageResponse{}
+	jsonBody, err := json.Marshal(image)
+	if err != nil {
+		return imageResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/catalog/products/%d/images/%d", productID, image.ID), reqBody)
+	if reqErr != nil {
+		return imageResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &imageResponse)
+	if jsonErr != nil {
+		return imageResponse.Data, jsonErr
+	}
+	return imageResponse.Data, nil
+}
+
+// Delete will remove an image from a product.
+func (s *ProductImageServiceOp) Delete(productID, imageID int) error {
+	return s.DeleteWithContext(context.Background(), productID, imageID)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *ProductImageServiceOp) DeleteWithContext(ctx context.Context, productID, imageID int) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/catalog/products/%d/images/%d", productID, imageID), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// Iterate will walk every image of a product, fetching further pages as needed.
+func (s *ProductImageServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, productID int, options ...ListOptions) *Iterator {
+	var listOptions ListOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListWithContext(ctx, productID, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
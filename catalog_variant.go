@@ -0,0 +1,359 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// MaxVariantBatchSize is the number of variants the API accepts in a single batch update.
+const MaxVariantBatchSize = 50
+
+type VariantService interface {
+	Get(int, int) (Variant, error)
+	GetWithContext(context.Context, int, int) (Variant, error)
+	List(int, ...ListVariantsOptions) (ListVariantResponse, error)
+	ListWithContext(context.Context, int, ...ListVariantsOptions) (ListVariantResponse, error)
+	Create(int, Variant) (Variant, error)
+	CreateWithContext(context.Context, int, Variant) (Variant, error)
+	Update(int, Variant) (Variant, error)
+	UpdateWithContext(context.Context, int, Variant) (Variant, error)
+	Delete(int, int) error
+	DeleteWithContext(context.Context, int, int) error
+	ListAll(...ListVariantsOptions) (ListVariantResponse, error)
+	ListAllWithContext(context.Context, ...ListVariantsOptions) (ListVariantResponse, error)
+	UpdateBatch([]Variant) ([]Variant, error)
+	UpdateBatchWithContext(context.Context, []Variant) ([]Variant, error)
+	Iterate(context.Context, IteratorOptions, int, ...ListVariantsOptions) *Iterator
+	IterateAll(context.Context, IteratorOptions, ...ListVariantsOptions) *Iterator
+	UploadImage(int, int, ImageUpload) (string, error)
+	UploadImageWithContext(context.Context, int, int, ImageUpload) (string, error)
+}
+
+type GetVariantResponse struct {
+	Data Variant `json:"data"`
+}
+
+type ListVariantResponse struct {
+	Data []Variant  `json:"data"`
+	Meta MetaResult `json:"meta"`
+}
+
+// Variant structure.
+// Fields left nil inherit the value of the parent product.
+type Variant struct {
+	ID                        int                  `json:"id,omitempty"`
+	ProductID                 int                  `json:"product_id,omitempty"`
+	SKU                       string               `json:"sku,omitempty"`
+	SKUID                     int                  `json:"sku_id,omitempty"`
+	Price                     *float64             `json:"price,omitempty"`
+	CalculatedPrice           float64              `json:"calculated_price,omitempty"`
+	SalePrice                 *float64             `json:"sale_price,omitempty"`
+	RetailPrice               *float64             `json:"retail_price,omitempty"`
+	MapPrice                  *float64             `json:"map_price,omitempty"`
+	CostPrice                 *float64             `json:"cost_price,omitempty"`
+	Weight                    *float64             `json:"weight,omitempty"`
+	CalculatedWeight          float64              `json:"calculated_weight,omitempty"`
+	Width                     *float64             `json:"width,omitempty"`
+	Height                    *float64             `json:"height,omitempty"`
+	Depth                     *float64             `json:"depth,omitempty"`
+	IsFreeShipping            *bool                `json:"is_free_shipping,omitempty"`
+	FixedCostShippingPrice    *float64             `json:"fixed_cost_shipping_price,omitempty"`
+	PurchasingDisabled        *bool                `json:"purchasing_disabled,omitempty"`
+	PurchasingDisabledMessage string               `json:"purchasing_disabled_message,omitempty"`
+	ImageURL                  string               `json:"image_url,omitempty"`
+	UPC                       string               `json:"upc,omitempty"`
+	MPN                       string               `json:"mpn,omitempty"`
+	GTIN                      string               `json:"gtin,omitempty"`
+	InventoryLevel            *int                 `json:"inventory_level,omitempty"`
+	InventoryWarningLevel     *int                 `json:"inventory_warning_level,omitempty"`
+	BinPickingNumber          string               `json:"bin_picking_number,omitempty"`
+	OptionValues              []VariantOptionValue `json:"option_values,omitempty"`
+}
+
+// VariantOptionValue identifies an option value the variant is made of.
+// When creating a variant only ID and OptionID are required.
+type VariantOptionValue struct {
+	ID                int    `json:"id,omitempty"`
+	OptionID          int    `json:"option_id,omitempty"`
+	Label             string `json:"label,omitempty"`
+	OptionDisplayName string `json:"option_display_name,omitempty"`
+}
+
+// ListVariantsOptions filters the variants returned by List and ListAll.
+type ListVariantsOptions struct {
+	ListOptions
+	ID            int      `url:"id,omitempty"`
+	IDIn          []int    `url:"id:in,omitempty"`
+	SKU           string   `url:"sku,omitempty"`
+	SKUIn         []string `url:"sku:in,omitempty"`
+	ProductID     int      `url:"product_id,omitempty"`
+	ProductIDIn   []int    `url:"product_id:in,omitempty"`
+	IncludeFields []string `url:"include_fields,omitempty"`
+	ExcludeFields []string `url:"exclude_fields,omitempty"`
+}
+
+type VariantServiceOp struct {
+	client *Client
+}
+
+// Get will fetch a single variant of a product.
+func (s *VariantServiceOp) Get(productID, variantID int) (Variant, error) {
+	return s.GetWithContext(context.Background(), productID, variantID)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *VariantServiceOp) GetWithContext(ctx context.Context, productID, variantID int) (Variant, error) {
+	variantResponse := GetVariantResponse{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/catalog/products/%d/variants/%d", productID, variantID), nil)
+	if reqErr != nil {
+		return variantResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &variantResponse)
+	if jsonErr != nil {
+		return variantResponse.Data, jsonErr
+	}
+	return variantResponse.Data, nil
+}
+
+// List will return a page of a product's variants matching the options.
+func (s *VariantServiceOp) List(productID int, options ...ListVariantsOptions) (ListVariantResponse, error) {
+	return s.ListWithContext(context.Background(), productID, options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *VariantServiceOp) ListWithContext(ctx context.Context, productID int, options ...ListVariantsOptions) (ListVariantResponse, error) {
+	listResult := ListVariantResponse{}
+
+	var listOptions ListVariantsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/catalog/products/%d/variants", productID), listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// Create will add a variant to a product.
+func (s *VariantServiceOp) Create(productID int, variant Variant) (Variant, error) {
+	return s.CreateWithContext(context.Background(), productID, variant)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *VariantServiceOp) CreateWithContext(ctx context.Context, productID int, variant Variant) (Variant, error) {
+	variantResponse := GetVariantResponse{}
+	jsonBody, err := json.Marshal(variant)
+	if err != nil {
+		return variantResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("/v3/catalog/products/%d/variants", productID), reqBody)
+	if reqErr != nil {
+		return variantResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &variantResponse)
+	if jsonErr != nil {
+		return variantResponse.Data, jsonErr
+	}
+	return variantResponse.Data, nil
+}
+
+// Update will update a single variant of a product.
+func (s *VariantServiceOp) Update(productID int, variant Variant) (Variant, error) {
+	return s.UpdateWithContext(context.Background(), productID, variant)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *VariantServiceOp) UpdateWithContext(ctx context.Context, productID int, variant Variant) (Variant, error) {
+	variantResponse := GetVariantResponse{}
+	jsonBody, err := json.Marshal(variant)
+	if err != nil {
+		return variantResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/catalog/products/%d/variants/%d", productID, variant.ID), reqBody)
+	if reqErr != nil {
+		return variantResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &variantResponse)
+	if jsonErr != nil {
+		return variantResponse.Data, jsonErr
+	}
+	return variantResponse.Data, nil
+}
+
+// Delete will remove a variant from a product.
+func (s *VariantServiceOp) Delete(productID, variantID int) error {
+	return s.DeleteWithContext(context.Background(), productID, variantID)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *VariantServiceOp) DeleteWithContext(ctx context.Context, productID, variantID int) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/catalog/products/%d/variants/%d", productID, variantID), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// ListAll will return a page of variants across all products matching the options.
+func (s *VariantServiceOp) ListAll(options ...ListVariantsOptions) (ListVariantResponse, error) {
+	return s.ListAllWithContext(context.Background(), options...)
+}
+
+// ListAllWithContext is the context-aware variant of ListAll.
+func (s *VariantServiceOp) ListAllWithContext(ctx context.Context, options ...ListVariantsOptions) (ListVariantResponse, error) {
+	listResult := ListVariantResponse{}
+
+	var listOptions ListVariantsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/catalog/variants", listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// UpdateBatch will update up to MaxVariantBatchSize variants of any products in a single request.
+// Every variant must have its ID set.
+func (s *VariantServiceOp) UpdateBatch(variants []Variant) ([]Variant, error) {
+	return s.UpdateBatchWithContext(context.Background(), variants)
+}
+
+// UpdateBatchWithContext is the context-aware variant of UpdateBatch.
+func (s *VariantServiceOp) UpdateBatchWithContext(ctx context.Context, variants []Variant) ([]Variant, error) {
+	listResult := ListVariantResponse{}
+	if len(variants) > MaxVariantBatchSize {
+		return listResult.Data, fmt.Errorf("bigcommerce: cannot update %d variants in one batch, the limit is %d", len(variants), MaxVariantBatchSize)
+	}
+
+	jsonBody, err := json.Marshal(variants)
+	if err != nil {
+		return listResult.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, "/v3/catalog/variants", reqBody)
+	if reqErr != nil {
+		return listResult.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult.Data, jsonErr
+	}
+	return listResult.Data, nil
+}
+
+// Iterate will walk every variant of a product matching the options, fetching further pages as needed.
+func (s *VariantServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, productID int, options ...ListVariantsOptions) *Iterator {
+	var listOptions ListVariantsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListWithContext(ctx, productID, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
+
+// IterateAll will walk every variant across all products matching the options, fetching further pages as needed.
+func (s *VariantServiceOp) IterateAll(ctx context.Context, iteratorOptions IteratorOptions, options ...ListVariantsOptions) *Iterator {
+	var listOptions ListVariantsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListAllWithContext(ctx, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
+
+// UploadImage will set the image of a variant and return the image URL.
+// upload may be a file or a URL BigCommerce downloads the image from.
+func (s *VariantServiceOp) UploadImage(productID, variantID int, upload ImageUpload) (string, error) {
+	return s.UploadImageWithContext(context.Background(), productID, variantID, upload)
+}
+
+// UploadImageWithContext is the context-aware variant of UploadImage.
+func (s *VariantServiceOp) UploadImageWithContext(ctx context.Context, productID, variantID int, upload ImageUpload) (string, error) {
+	if err := upload.validate(); err != nil {
+		return "", err
+	}
+
+	if !upload.isFile() {
+		imageResponse := ImageURLResponse{}
+		jsonBody, err := json.Marshal(map[string]string{"image_url": upload.ImageURL})
+		if err != nil {
+			return imageResponse.Data.ImageURL, err
+		}
+		body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("/v3/catalog/products/%d/variants/%d/image", productID, variantID), bytes.NewReader(jsonBody))
+		if reqErr != nil {
+			return imageResponse.Data.ImageURL, reqErr
+		}
+
+		jsonErr := json.Unmarshal(body, &imageResponse)
+		if jsonErr != nil {
+			return imageResponse.Data.ImageURL, jsonErr
+		}
+		return imageResponse.Data.ImageURL, nil
+	}
+
+	imageResponse := ImageURLResponse{}
+	body, reqErr := s.client.uploadImage(ctx, http.MethodPost, fmt.Sprintf("/v3/catalog/products/%d/variants/%d/image", productID, variantID), upload, nil)
+	if reqErr != nil {
+		return imageResponse.Data.ImageURL, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &imageResponse)
+	if jsonErr != nil {
+		return imageResponse.Data.ImageURL, jsonErr
+	}
+	return imageResponse.Data.ImageURL, nil
+}
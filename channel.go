@@ -0,0 +1,417 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Channel types, as used by Channel.Type.
+const (
+	ChannelTypeStorefront  = "storefront"
+	ChannelTypeMarketplace = "marketplace"
+	ChannelTypeMarketing   = "marketing"
+	ChannelTypePOS         = "pos"
+)
+
+// Channel statuses, as used by Channel.Status.
+const (
+	ChannelStatusActive       = "active"
+	ChannelStatusPrelaunch    = "prelaunch"
+	ChannelStatusInactive     = "inactive"
+	ChannelStatusConnected    = "connected"
+	ChannelStatusDisconnected = "disconnected"
+	ChannelStatusArchived     = "archived"
+)
+
+// ChannelIncludeCurrencies returns the currency assignment with each channel.
+const ChannelIncludeCurrencies = "currencies"
+
+// ChannelsService groups the channel and site services.
+type ChannelsService struct {
+	Channels ChannelService
+	Listings ChannelListingService
+	Sites    SiteService
+}
+
+type ChannelService interface {
+	Get(int, ...GetChannelOptions) (Channel, error)
+	GetWithContext(context.Context, int, ...GetChannelOptions) (Channel, error)
+	List(...ListChannelsOptions) (ListChannelResponse, error)
+	ListWithContext(context.Context, ...ListChannelsOptions) (ListChannelResponse, error)
+	Create(Channel) (Channel, error)
+	CreateWithContext(context.Context, Channel) (Channel, error)
+	Update(Channel) (Channel, error)
+	UpdateWithContext(context.Context, Channel) (Channel, error)
+	Iterate(context.Context, IteratorOptions, ...ListChannelsOptions) *Iterator
+	GetMenus(int) (ChannelMenus, error)
+	GetMenusWithContext(context.Context, int) (ChannelMenus, error)
+	CreateMenus(int, ChannelMenus) (ChannelMenus, error)
+	CreateMenusWithContext(context.Context, int, ChannelMenus) (ChannelMenus, error)
+	DeleteMenus(int) error
+	DeleteMenusWithContext(context.Context, int) error
+	GetCurrencyAssignment(int) (CurrencyAssignment, error)
+	GetCurrencyAssignmentWithContext(context.Context, int) (CurrencyAssignment, error)
+	CreateCurrencyAssignment(int, CurrencyAssignment) (CurrencyAssignment, error)
+	CreateCurrencyAssignmentWithContext(context.Context, int, CurrencyAssignment) (CurrencyAssignment, error)
+	UpdateCurrencyAssignment(int, CurrencyAssignment) (CurrencyAssignment, error)
+	UpdateCurrencyAssignmentWithContext(context.Context, int, CurrencyAssignment) (CurrencyAssignment, error)
+	DeleteCurrencyAssignment(int) error
+	DeleteCurrencyAssignmentWithContext(context.Context, int) error
+}
+
+type GetChannelResponse struct {
+	Data Channel `json:"data"`
+}
+
+type ListChannelResponse struct {
+	Data []Channel  `json:"data"`
+	Meta MetaResult `json:"meta"`
+}
+
+type GetChannelMenusResponse struct {
+	Data ChannelMenus `json:"data"`
+}
+
+type GetCurrencyAssignmentResponse struct {
+	Data CurrencyAssignment `json:"data"`
+}
+
+// Channel structure.
+type Channel struct {
+	ID               int                 `json:"id,omitempty"`
+	Name             string              `json:"name,omitempty"`
+	Type             string              `json:"type,omitempty"`
+	Platform         string              `json:"platform,omitempty"`
+	Status           string              `json:"status,omitempty"`
+	ExternalID       string              `json:"external_id,omitempty"`
+	IsListableFromUI *bool               `json:"is_listable_from_ui,omitempty"`
+	IsVisible        *bool               `json:"is_visible,omitempty"`
+	IconURL          string              `json:"icon_url,omitempty"`
+	Currencies       *CurrencyAssignment `json:"currencies,omitempty"`
+	DateCreated      string              `json:"date_created,omitempty"`
+	DateModified     string              `json:"date_modified,omitempty"`
+}
+
+// ChannelMenus are the control panel sections shown for a channel.
+type ChannelMenus struct {
+	BigCommerceProtectedAppSections []string             `json:"bigcommerce_protected_app_sections,omitempty"`
+	CustomAppSections               []ChannelMenuSection `json:"custom_app_sections,omitempty"`
+}
+
+// ChannelMenuSection is a control panel section provided by an app.
+type ChannelMenuSection struct {
+	Title     string `json:"title"`
+	QueryPath string `json:"query_path"`
+}
+
+// CurrencyAssignment lists the currencies enabled on a channel.
+type CurrencyAssignment struct {
+	ChannelID         int      `json:"channel_id,omitempty"`
+	EnabledCurrencies []string `json:"enabled_currencies"`
+	DefaultCurrency   string   `json:"default_currency"`
+}
+
+// GetChannelOptions controls the sub-resources returned with a channel.
+type GetChannelOptions struct {
+	Include []string `url:"include,omitempty"`
+}
+
+// ListChannelsOptions filters the channels returned by List.
+type ListChannelsOptions struct {
+	ListOptions
+	GetChannelOptions
+	Available       *bool     `url:"available,omitempty"`
+	StatusIn        []string  `url:"status:in,omitempty"`
+	TypeIn          []string  `url:"type:in,omitempty"`
+	PlatformIn      []string  `url:"platform:in,omitempty"`
+	DateCreatedMin  time.Time `url:"date_created:min,omitempty"`
+	DateCreatedMax  time.Time `url:"date_created:max,omitempty"`
+	DateModifiedMin time.Time `url:"date_modified:min,omitempty"`
+	DateModifiedMax time.Time `url:"date_modified:max,omitempty"`
+}
+
+type ChannelServiceOp struct {
+	client *Client
+}
+
+// Get will fetch a single channel by the provided ID.
+func (s *ChannelServiceOp) Get(id int, options ...GetChannelOptions) (Channel, error) {
+	return s.GetWithContext(context.Background(), id, options...)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *ChannelServiceOp) GetWithContext(ctx context.Context, id int, options ...GetChannelOptions) (Channel, error) {
+	channelResponse := GetChannelResponse{}
+
+	var getOptions GetChannelOptions
+	if len(options) > 0 {
+		getOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/channels/%d", id), getOptions)
+	if err != nil {
+		return channelResponse.Data, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return channelResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &channelResponse)
+	if jsonErr != nil {
+		return channelResponse.Data, jsonErr
+	}
+	return channelResponse.Data, nil
+}
+
+// List will return a page of channels matching the options.
+func (s *ChannelServiceOp) List(options ...ListChannelsOptions) (ListChannelResponse, error) {
+	return s.ListWithContext(context.Background(), options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *ChannelServiceOp) ListWithContext(ctx context.Context, options ...ListChannelsOptions) (ListChannelResponse, error) {
+	listResult := ListChannelResponse{}
+
+	var listOptions ListChannelsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/channels", listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// Create will create a new channel.
+// The fields required on a channel are: Name, Type and Platform.
+func (s *ChannelServiceOp) Create(channel Channel) (Channel, error) {
+	return s.CreateWithContext(context.Background(), channel)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *ChannelServiceOp) CreateWithContext(ctx context.Context, channel Channel) (Channel, error) {
+	channelResponse := GetChannelResponse{}
+	jsonBody, err := json.Marshal(channel)
+	if err != nil {
+		return channelResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, "/v3/channels", reqBody)
+	if reqErr != nil {
+		return channelResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &channelResponse)
+	if jsonErr != nil {
+		return channelResponse.Data, jsonErr
+	}
+	return channelResponse.Data, nil
+}
+
+// Update will update an existing channel. Channels cannot be deleted; set Status to
+// ChannelStatusArchived instead.
+func (s *ChannelServiceOp) Update(channel Channel) (Channel, error) {
+	return s.UpdateWithContext(context.Background(), channel)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *ChannelServiceOp) UpdateWithContext(ctx context.Context, channel Channel) (Channel, error) {
+	channelResponse := GetChannelResponse{}
+	jsonBody, err := json.Marshal(channel)
+	if err != nil {
+		return channelResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/channels/%d", channel.ID), reqBody)
+	if reqErr != nil {
+		return channelResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &channelResponse)
+	if jsonErr != nil {
+		return channelResponse.Data, jsonErr
+	}
+	return channelResponse.Data, nil
+}
+
+// Iterate will walk every channel matching the options, fetching further pages as needed.
+func (s *ChannelServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, options ...ListChannelsOptions) *Iterator {
+	var listOptions ListChannelsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListWithContext(ctx, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
+
+// GetMenus will fetch the control panel menus of a channel.
+func (s *ChannelServiceOp) GetMenus(channelID int) (ChannelMenus, error) {
+	return s.GetMenusWithContext(context.Background(), channelID)
+}
+
+// GetMenusWithContext is the context-aware variant of GetMenus.
+func (s *ChannelServiceOp) GetMenusWithContext(ctx context.Context, channelID int) (ChannelMenus, error) {
+	menusResponse := GetChannelMenusResponse{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/channels/%d/channel-menus", channelID), nil)
+	if reqErr != nil {
+		return menusResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &menusResponse)
+	if jsonErr != nil {
+		return menusResponse.Data, jsonErr
+	}
+	return menusResponse.Data, nil
+}
+
+// CreateMenus will set the control panel menus of a channel.
+func (s *ChannelServiceOp) CreateMenus(channelID int, menus ChannelMenus) (ChannelMenus, error) {
+	return s.CreateMenusWithContext(context.Background(), channelID, menus)
+}
+
+// CreateMenusWithContext is the context-aware variant of CreateMenus.
+func (s *ChannelServiceOp) CreateMenusWithContext(ctx context.Context, channelID int, menus ChannelMenus) (ChannelMenus, error) {
+	menusResponse := GetChannelMenusResponse{}
+	jsonBody, err := json.Marshal(menus)
+	if err != nil {
+		return menusResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("/v3/channels/%d/channel-menus", channelID), reqBody)
+	if reqErr != nil {
+		return menusResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &menusResponse)
+	if jsonErr != nil {
+		return menusResponse.Data, jsonErr
+	}
+	return menusResponse.Data, nil
+}
+
+// DeleteMenus will remove the control panel menus of a channel.
+func (s *ChannelServiceOp) DeleteMenus(channelID int) error {
+	return s.DeleteMenusWithContext(context.Background(), channelID)
+}
+
+// DeleteMenusWithContext is the context-aware variant of DeleteMenus.
+func (s *ChannelServiceOp) DeleteMenusWithContext(ctx context.Context, channelID int) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/channels/%d/channel-menus", channelID), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// GetCurrencyAssignment will fetch the currencies enabled on a channel.
+func (s *ChannelServiceOp) GetCurrencyAssignment(channelID int) (CurrencyAssignment, error) {
+	return s.GetCurrencyAssignmentWithContext(context.Background(), channelID)
+}
+
+// GetCurrencyAssignmentWithContext is the context-aware variant of GetCurrencyAssignment.
+func (s *ChannelServiceOp) GetCurrencyAssignmentWithContext(ctx context.Context, channelID int) (CurrencyAssignment, error) {
+	assignmentResponse := GetCurrencyAssignmentResponse{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/channels/%d/currency-assignments", channelID), nil)
+	if reqErr != nil {
+		return assignmentResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &assignmentResponse)
+	if jsonErr != nil {
+		return assignmentResponse.Data, jsonErr
+	}
+	return assignmentResponse.Data, nil
+}
+
+// CreateCurrencyAssignment will enable currencies on a channel that has none assigned.
+func (s *ChannelServiceOp) CreateCurrencyAssignment(channelID int, assignment CurrencyAssignment) (CurrencyAssignment, error) {
+	return s.CreateCurrencyAssignmentWithContext(context.Background(), channelID, assignment)
+}
+
+// CreateCurrencyAssignmentWithContext is the context-aware variant of CreateCurrencyAssignment.
+func (s *ChannelServiceOp) CreateCurrencyAssignmentWithContext(ctx context.Context, channelID int, assignment CurrencyAssignment) (CurrencyAssignment, error) {
+	assignmentResponse := GetCurrencyAssignmentResponse{}
+	jsonBody, err := json.Marshal(assignment)
+	if err != nil {
+		return assignmentResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("/v3/channels/%d/currency-assignments", channelID), reqBody)
+	if reqErr != nil {
+		return assignmentResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &assignmentResponse)
+	if jsonErr != nil {
+		return assignmentResponse.Data, jsonErr
+	}
+	return assignmentResponse.Data, nil
+}
+
+// UpdateCurrencyAssignment will replace the currencies enabled on a channel.
+func (s *ChannelServiceOp) UpdateCurrencyAssignment(channelID int, assignment CurrencyAssignment) (CurrencyAssignment, error) {
+	return s.UpdateCurrencyAssignmentWithContext(context.Background(), channelID, assignment)
+}
+
+// UpdateCurrencyAssignmentWithContext is the context-aware variant of UpdateCurrencyAssignment.
+func (s *ChannelServiceOp) UpdateCurrencyAssignmentWithContext(ctx context.Context, channelID int, assignment CurrencyAssignment) (CurrencyAssignment, error) {
+	assignmentResponse := GetCurrencyAssignmentResponse{}
+	jsonBody, err := json.Marshal(assignment)
+	if err != nil {
+		return assignmentResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/channels/%d/currency-assignments", channelID), reqBody)
+	if reqErr != nil {
+		return assignmentResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &assignmentResponse)
+	if jsonErr != nil {
+		return assignmentResponse.Data, jsonErr
+	}
+	return assignmentResponse.Data, nil
+}
+
+// DeleteCurrencyAssignment will remove the currency assignment of a channel, which
+// then falls back to the store's currencies.
+func (s *ChannelServiceOp) DeleteCurrencyAssignment(channelID int) error {
+	return s.DeleteCurrencyAssignmentWithContext(context.Background(), channelID)
+}
+
+// DeleteCurrencyAssignmentWithContext is the context-aware variant of DeleteCurrencyAssignment.
+func (s *ChannelServiceOp) DeleteCurrencyAssignmentWithContext(ctx context.Context, channelID int) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/channels/%d/currency-assignments", channelID), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Listing states, as used by ChannelListing.State.
+const (
+	ListingStateActive         = "active"
+	ListingStateDisabled       = "disabled"
+	ListingStateError          = "error"
+	ListingStatePending        = "pending"
+	ListingStatePendingDisable = "pending_disable"
+	ListingStatePendingDelete  = "pending_delete"
+	ListingStateQueued         = "queued"
+	ListingStateRejected       = "rejected"
+	ListingStateSubmitted      = "submitted"
+	ListingStateDeleted        = "deleted"
+)
+
+type ChannelListingService interface {
+	Get(int, int) (ChannelListing, error)
+	GetWithContext(context.Context, int, int) (ChannelListing, error)
+	List(int, ...ListChannelListingsOptions) (ListChannelListingResponse, error)
+	ListWithContext(context.Context, int, ...ListChannelListingsOptions) (ListChannelListingResponse, error)
+	Create(int, []ChannelListing) ([]ChannelListing, error)
+	CreateWithContext(context.Context, int, []ChannelListing) ([]ChannelListing, error)
+	Update(int, []ChannelListing) ([]ChannelListing, error)
+	UpdateWithContext(context.Context, int, []ChannelListing) ([]ChannelListing, error)
+	Iterate(context.Context, IteratorOptions, int, ...ListChannelListingsOptions) *Iterator
+}
+
+type GetChannelListingResponse struct {
+	Data ChannelListing `json:"data"`
+}
+
+type ListChannelListingResponse struct {
+	Data []ChannelListing `json:"data"`
+	Meta MetaResult       `json:"meta"`
+}
+
+// ChannelListing is a product listed on a channel.
+type ChannelListing struct {
+	ListingID    int                     `json:"listing_id,omitempty"`
+	ChannelID    int                     `json:"channel_id,omitempty"`
+	ProductID    int                     `json:"product_id,omitempty"`
+	ExternalID   string                  `json:"external_id,omitempty"`
+	State        string                  `json:"state,omitempty"`
+	Name         string                  `json:"name,omitempty"`
+	Description  string                  `json:"description,omitempty"`
+	Variants     []ChannelListingVariant `json:"variants,omitempty"`
+	DateCreated  string                  `json:"date_created,omitempty"`
+	DateModified string                  `json:"date_modified,omitempty"`
+}
+
+// ChannelListingVariant is a variant of a listed product.
+type ChannelListingVariant struct {
+	ProductID   int    `json:"product_id,omitempty"`
+	VariantID   int    `json:"variant_id,omitempty"`
+	ExternalID  string `json:"external_id,omitempty"`
+	State       string `json:"state,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// ListChannelListingsOptions filters the listings returned by List.
+type ListChannelListingsOptions struct {
+	ListOptions
+	ProductIDIn []int `url:"product_id:in,omitempty"`
+}
+
+type ChannelListingServiceOp struct {
+	client *Client
+}
+
+// Get will fetch a single listing of a channel.
+func (s *ChannelListingServiceOp) Get(channelID, listingID int) (ChannelListing, error) {
+	return s.GetWithContext(context.Background(), channelID, listingID)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *ChannelListingServiceOp) GetWithContext(ctx context.Context, channelID, listingID int) (ChannelListing, error) {
+	listingResponse := GetChannelListingResponse{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/channels/%d/listings/%d", channelID, listingID), nil)
+	if reqErr != nil {
+		return listingResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listingResponse)
+	if jsonErr != nil {
+		return listingResponse.Data, jsonErr
+	}
+	return listingResponse.Data, nil
+}
+
+// List will return a page of the listings of a channel matching the options.
+func (s *ChannelListingServiceOp) List(channelID int, options ...ListChannelListingsOptions) (ListChannelListingResponse, error) {
+	return s.ListWithContext(context.Background(), channelID, options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *ChannelListingServiceOp) ListWithContext(ctx context.Context, channelID int, options ...ListChannelListingsOptions) (ListChannelListingResponse, error) {
+	listResult := ListChannelListingResponse{}
+
+	var listOptions ListChannelListingsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/channels/%d/listings", channelID), listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// Create will list the provided products on a channel.
+func (s *ChannelListingServiceOp) Create(channelID int, listings []ChannelListing) ([]ChannelListing, error) {
+	return s.CreateWithContext(context.Background(), channelID, listings)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *ChannelListingServiceOp) CreateWithContext(ctx context.Context, channelID int, listings []ChannelListing) ([]ChannelListing, error) {
+	listResult := ListChannelListingResponse{}
+	jsonBody, err := json.Marshal(listings)
+	if err != nil {
+		return listResult.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("/v3/channels/%d/listings", channelID), reqBody)
+	if reqErr != nil {
+		return listResult.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult.Data, jsonErr
+	}
+	return listResult.Data, nil
+}
+
+// Update will update the provided listings of a channel.
+// Every listing must have its ListingID set.
+func (s *ChannelListingServiceOp) Update(channelID int, listings []ChannelListing) ([]ChannelListing, error) {
+	return s.UpdateWithContext(context.Background(), channelID, listings)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *ChannelListingServiceOp) UpdateWithContext(ctx context.Context, channelID int, listings []ChannelListing) ([]ChannelListing, error) {
+	listResult := ListChannelListingResponse{}
+	jsonBody, err := json.Marshal(listings)
+	if err != nil {
+		return listResult.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/channels/%d/listings", channelID), reqBody)
+	if reqErr != nil {
+		return listResult.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult.Data, jsonErr
+	}
+	return listResult.Data, nil
+}
+
+// Iterate will walk every listing matching the options, fetching further pages as needed.
+func (s *ChannelListingServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, channelID int, options ...ListChannelListingsOptions) *Iterator {
+	var listOptions ListChannelListingsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListWithContext(ctx, channelID, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
@@ -0,0 +1,458 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Sub-resources that can be requested with GetCheckoutOptions.Include.
+const (
+	CheckoutIncludeAvailableShippingOptions = "consignments.available_shipping_options"
+	CheckoutIncludePhysicalItemOptions      = "cart.line_items.physical_items.options"
+	CheckoutIncludeDigitalItemOptions       = "cart.line_items.digital_items.options"
+	CheckoutIncludePromotionsBanners        = "promotions.banners"
+)
+
+type CheckoutService interface {
+	Get(string, ...GetCheckoutOptions) (Checkout, error)
+	GetWithContext(context.Context, string, ...GetCheckoutOptions) (Checkout, error)
+	Update(string, string) (Checkout, error)
+	UpdateWithContext(context.Context, string, string) (Checkout, error)
+	AddBillingAddress(string, CheckoutAddress, ...GetCheckoutOptions) (Checkout, error)
+	AddBillingAddressWithContext(context.Context, string, CheckoutAddress, ...GetCheckoutOptions) (Checkout, error)
+	UpdateBillingAddress(string, CheckoutAddress, ...GetCheckoutOptions) (Checkout, error)
+	UpdateBillingAddressWithContext(context.Context, string, CheckoutAddress, ...GetCheckoutOptions) (Checkout, error)
+	AddConsignments(string, []ConsignmentRequest, ...GetCheckoutOptions) (Checkout, error)
+	AddConsignmentsWithContext(context.Context, string, []ConsignmentRequest, ...GetCheckoutOptions) (Checkout, error)
+	UpdateConsignment(string, string, ConsignmentRequest, ...GetCheckoutOptions) (Checkout, error)
+	UpdateConsignmentWithContext(context.Context, string, string, ConsignmentRequest, ...GetCheckoutOptions) (Checkout, error)
+	SelectShippingOption(string, string, string, ...GetCheckoutOptions) (Checkout, error)
+	SelectShippingOptionWithContext(context.Context, string, string, string, ...GetCheckoutOptions) (Checkout, error)
+	DeleteConsignment(string, string) (Checkout, error)
+	DeleteConsignmentWithContext(context.Context, string, string) (Checkout, error)
+	AddCoupon(string, string) (Checkout, error)
+	AddCouponWithContext(context.Context, string, string) (Checkout, error)
+	DeleteCoupon(string, string) (Checkout, error)
+	DeleteCouponWithContext(context.Context, string, string) (Checkout, error)
+	CreateOrder(string) (int, error)
+	CreateOrderWithContext(context.Context, string) (int, error)
+}
+
+type GetCheckoutResponse struct {
+	Data Checkout `json:"data"`
+}
+
+// Checkout structure.
+type Checkout struct {
+	ID                      string           `json:"id"`
+	Cart                    Cart             `json:"cart"`
+	BillingAddress          *CheckoutAddress `json:"billing_address,omitempty"`
+	Consignments            []Consignment    `json:"consignments"`
+	Taxes                   []CheckoutTax    `json:"taxes"`
+	Coupons                 []CartCoupon     `json:"coupons"`
+	OrderID                 int              `json:"order_id"`
+	ShippingCostTotalIncTax float64          `json:"shipping_cost_total_inc_tax"`
+	ShippingCostTotalExTax  float64          `json:"shipping_cost_total_ex_tax"`
+	HandlingCostTotalIncTax float64          `json:"handling_cost_total_inc_tax"`
+	HandlingCostTotalExTax  float64          `json:"handling_cost_total_ex_tax"`
+	TaxTotal                float64          `json:"tax_total"`
+	SubtotalIncTax          float64          `json:"subtotal_inc_tax"`
+	SubtotalExTax           float64          `json:"subtotal_ex_tax"`
+	GrandTotal              float64          `json:"grand_total"`
+	OutstandingBalance      float64          `json:"outstanding_balance"`
+	IsStoreCreditApplied    bool             `json:"is_store_credit_applied"`
+	CustomerMessage         string           `json:"customer_message"`
+	CreatedTime             string           `json:"created_time"`
+	UpdatedTime             string           `json:"updated_time"`
+}
+
+// CheckoutAddress is a billing or shipping address of a checkout.
+type CheckoutAddress struct {
+	ID                  string                       `json:"id,omitempty"`
+	FirstName           string                       `json:"first_name,omitempty"`
+	LastName            string                       `json:"last_name,omitempty"`
+	Email               string                       `json:"email,omitempty"`
+	Company             string                       `json:"company,omitempty"`
+	Address1            string                       `json:"address1,omitempty"`
+	Address2            string                       `json:"address2,omitempty"`
+	City                string                       `json:"city,omitempty"`
+	StateOrProvince     string                       `json:"state_or_province,omitempty"`
+	StateOrProvinceCode string                       `json:"state_or_province_code,omitempty"`
+	CountryCode         string                       `json:"country_code,omitempty"`
+	PostalCode          string                       `json:"postal_code,omitempty"`
+	Phone               string                       `json:"phone,omitempty"`
+	CustomFields        []CheckoutAddressCustomField `json:"custom_fields,omitempty"`
+}
+
+// CheckoutAddressCustomField is the value of a custom address form field.
+type CheckoutAddressCustomField struct {
+	FieldID    string      `json:"field_id"`
+	FieldValue interface{} `json:"field_value"`
+}
+
+// Consignment is a group of line items shipped to one address.
+type Consignment struct {
+	ID                       string           `json:"id"`
+	ShippingAddress          CheckoutAddress  `json:"shipping_address"`
+	LineItemIDs              []string         `json:"line_item_ids"`
+	AvailableShippingOptions []ShippingOption `json:"available_shipping_options,omitempty"`
+	SelectedShippingOption   *ShippingOption  `json:"selected_shipping_option,omitempty"`
+	ShippingCostIncTax       float64          `json:"shipping_cost_inc_tax"`
+	ShippingCostExTax        float64          `json:"shipping_cost_ex_tax"`
+	HandlingCostIncTax       float64          `json:"handling_cost_inc_tax"`
+	HandlingCostExTax        float64          `json:"handling_cost_ex_tax"`
+}
+
+// ShippingOption is a way to ship a consignment.
+type ShippingOption struct {
+	ID                    string  `json:"id"`
+	Type                  string  `json:"type"`
+	Description           string  `json:"description"`
+	ImageURL              string  `json:"image_url"`
+	Cost                  float64 `json:"cost"`
+	TransitTime           string  `json:"transit_time"`
+	AdditionalDescription string  `json:"additional_description"`
+}
+
+// ConsignmentRequest is the body used to add or update a consignment.
+type ConsignmentRequest struct {
+	Address          *CheckoutAddress      `json:"address,omitempty"`
+	LineItems        []ConsignmentLineItem `json:"line_items,omitempty"`
+	ShippingOptionID string                `json:"shipping_option_id,omitempty"`
+}
+
+// ConsignmentLineItem is a quantity of a cart line item in a consignment.
+type ConsignmentLineItem struct {
+	ItemID   string `json:"item_id"`
+	Quantity int    `json:"quantity"`
+}
+
+// CheckoutTax is a tax charged on a checkout.
+type CheckoutTax struct {
+	Name   string  `json:"name"`
+	Amount float64 `json:"amount"`
+}
+
+// GetCheckoutOptions controls the sub-resources returned with a checkout.
+type GetCheckoutOptions struct {
+	Include []string `url:"include,omitempty"`
+}
+
+type checkoutMessage struct {
+	CustomerMessage string `json:"customer_message"`
+}
+
+type checkoutCoupon struct {
+	CouponCode string `json:"coupon_code"`
+}
+
+type checkoutOrderResponse struct {
+	Data struct {
+		ID int `json:"id"`
+	} `json:"data"`
+}
+
+type CheckoutServiceOp struct {
+	client *Client
+}
+
+// Get will fetch a single checkout by the provided ID, which is the ID of its cart.
+func (s *CheckoutServiceOp) Get(checkoutID string, options ...GetCheckoutOptions) (Checkout, error) {
+	return s.GetWithContext(context.Background(), checkoutID, options...)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *CheckoutServiceOp) GetWithContext(ctx context.Context, checkoutID string, options ...GetCheckoutOptions) (Checkout, error) {
+	checkoutResponse := GetCheckoutResponse{}
+
+	var getOptions GetCheckoutOptions
+	if len(options) > 0 {
+		getOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/checkouts/%s", checkoutID), getOptions)
+	if err != nil {
+		return checkoutResponse.Data, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return checkoutResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &checkoutResponse)
+	if jsonErr != nil {
+		return checkoutResponse.Data, jsonErr
+	}
+	return checkoutResponse.Data, nil
+}
+
+// Update will set the customer message of a checkout.
+func (s *CheckoutServiceOp) Update(checkoutID, customerMessage string) (Checkout, error) {
+	return s.UpdateWithContext(context.Background(), checkoutID, customerMessage)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *CheckoutServiceOp) UpdateWithContext(ctx context.Context, checkoutID, customerMessage string) (Checkout, error) {
+	checkoutResponse := GetCheckoutResponse{}
+	jsonBody, err := json.Marshal(checkoutMessage{CustomerMessage: customerMessage})
+	if err != nil {
+		return checkoutResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/checkouts/%s", checkoutID), reqBody)
+	if reqErr != nil {
+		return checkoutResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &checkoutResponse)
+	if jsonErr != nil {
+		return checkoutResponse.Data, jsonErr
+	}
+	return checkoutResponse.Data, nil
+}
+
+// AddBillingAddress will set the billing address of a checkout.
+func (s *CheckoutServiceOp) AddBillingAddress(checkoutID string, address CheckoutAddress, options ...GetCheckoutOptions) (Checkout, error) {
+	return s.AddBillingAddressWithContext(context.Background(), checkoutID, address, options...)
+}
+
+// AddBillingAddressWithContext is the context-aware variant of AddBillingAddress.
+func (s *CheckoutServiceOp) AddBillingAddressWithContext(ctx context.Context, checkoutID string, address CheckoutAddress, options ...GetCheckoutOptions) (Checkout, error) {
+	checkoutResponse := GetCheckoutResponse{}
+
+	var getOptions GetCheckoutOptions
+	if len(options) > 0 {
+		getOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/checkouts/%s/billing-address", checkoutID), getOptions)
+	if err != nil {
+		return checkoutResponse.Data, err
+	}
+
+	jsonBody, err := json.Marshal(address)
+	if err != nil {
+		return checkoutResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, path, reqBody)
+	if reqErr != nil {
+		return checkoutResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &checkoutResponse)
+	if jsonErr != nil {
+		return checkoutResponse.Data, jsonErr
+	}
+	return checkoutResponse.Data, nil
+}
+
+// UpdateBillingAddress will update the billing address of a checkout.
+func (s *CheckoutServiceOp) UpdateBillingAddress(checkoutID string, address CheckoutAddress, options ...GetCheckoutOptions) (Checkout, error) {
+	return s.UpdateBillingAddressWithContext(context.Background(), checkoutID, address, options...)
+}
+
+// UpdateBillingAddressWithContext is the context-aware variant of UpdateBillingAddress.
+func (s *CheckoutServiceOp) UpdateBillingAddressWithContext(ctx context.Context, checkoutID string, address CheckoutAddress, options ...GetCheckoutOptions) (Checkout, error) {
+	checkoutResponse := GetCheckoutResponse{}
+
+	var getOptions GetCheckoutOptions
+	if len(options) > 0 {
+		getOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/checkouts/%s/billing-address/%s", checkoutID, address.ID), getOptions)
+	if err != nil {
+		return checkoutResponse.Data, err
+	}
+
+	jsonBody, err := json.Marshal(address)
+	if err != nil {
+		return checkoutResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, path, reqBody)
+	if reqErr != nil {
+		return checkoutResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &checkoutResponse)
+	if jsonErr != nil {
+		return checkoutResponse.Data, jsonErr
+	}
+	return checkoutResponse.Data, nil
+}
+
+// AddConsignments will add consignments, grouping line items by shipping address, to a checkout.
+// Include CheckoutIncludeAvailableShippingOptions to get the shipping options of each consignment.
+func (s *CheckoutServiceOp) AddConsignments(checkoutID string, consignments []ConsignmentRequest, options ...GetCheckoutOptions) (Checkout, error) {
+	return s.AddConsignmentsWithContext(context.Background(), checkoutID, consignments, options...)
+}
+
+// AddConsignmentsWithContext is the context-aware variant of AddConsignments.
+func (s *CheckoutServiceOp) AddConsignmentsWithContext(ctx context.Context, checkoutID string, consignments []ConsignmentRequest, options ...GetCheckoutOptions) (Checkout, error) {
+	checkoutResponse := GetCheckoutResponse{}
+
+	var getOptions GetCheckoutOptions
+	if len(options) > 0 {
+		getOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/checkouts/%s/consignments", checkoutID), getOptions)
+	if err != nil {
+		return checkoutResponse.Data, err
+	}
+
+	jsonBody, err := json.Marshal(consignments)
+	if err != nil {
+		return checkoutResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, path, reqBody)
+	if reqErr != nil {
+		return checkoutResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &checkoutResponse)
+	if jsonErr != nil {
+		return checkoutResponse.Data, jsonErr
+	}
+	return checkoutResponse.Data, nil
+}
+
+// UpdateConsignment will update a consignment of a checkout, such as its address or selected shipping option.
+func (s *CheckoutServiceOp) UpdateConsignment(checkoutID, consignmentID string, consignment ConsignmentRequest, options ...GetCheckoutOptions) (Checkout, error) {
+	return s.UpdateConsignmentWithContext(context.Background(), checkoutID, consignmentID, consignment, options...)
+}
+
+// UpdateConsignmentWithContext is the context-aware variant of UpdateConsignment.
+func (s *CheckoutServiceOp) UpdateConsignmentWithContext(ctx context.Context, checkoutID, consignmentID string, consignment ConsignmentRequest, options ...GetCheckoutOptions) (Checkout, error) {
+	checkoutResponse := GetCheckoutResponse{}
+
+	var getOptions GetCheckoutOptions
+	if len(options) > 0 {
+		getOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/checkouts/%s/consignments/%s", checkoutID, consignmentID), getOptions)
+	if err != nil {
+		return checkoutResponse.Data, err
+	}
+
+	jsonBody, err := json.Marshal(consignment)
+	if err != nil {
+		return checkoutResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, path, reqBody)
+	if reqErr != nil {
+		return checkoutResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &checkoutResponse)
+	if jsonErr != nil {
+		return checkoutResponse.Data, jsonErr
+	}
+	return checkoutResponse.Data, nil
+}
+
+// SelectShippingOption will select the shipping option of a consignment.
+func (s *CheckoutServiceOp) SelectShippingOption(checkoutID, consignmentID, shippingOptionID string, options ...GetCheckoutOptions) (Checkout, error) {
+	return s.SelectShippingOptionWithContext(context.Background(), checkoutID, consignmentID, shippingOptionID, options...)
+}
+
+// SelectShippingOptionWithContext is the context-aware variant of SelectShippingOption.
+func (s *CheckoutServiceOp) SelectShippingOptionWithContext(ctx context.Context, checkoutID, consignmentID, shippingOptionID string, options ...GetCheckoutOptions) (Checkout, error) {
+	return s.UpdateConsignmentWithContext(ctx, checkoutID, consignmentID, ConsignmentRequest{ShippingOptionID: shippingOptionID}, options...)
+}
+
+// DeleteConsignment will remove a consignment from a checkout.
+func (s *CheckoutServiceOp) DeleteConsignment(checkoutID, consignmentID string) (Checkout, error) {
+	return s.DeleteConsignmentWithContext(context.Background(), checkoutID, consignmentID)
+}
+
+// DeleteConsignmentWithContext is the context-aware variant of DeleteConsignment.
+func (s *CheckoutServiceOp) DeleteConsignmentWithContext(ctx context.Context, checkoutID, consignmentID string) (Checkout, error) {
+	checkoutResponse := GetCheckoutResponse{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/checkouts/%s/consignments/%s", checkoutID, consignmentID), nil)
+	if reqErr != nil {
+		return checkoutResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &checkoutResponse)
+	if jsonErr != nil {
+		return checkoutResponse.Data, jsonErr
+	}
+	return checkoutResponse.Data, nil
+}
+
+// AddCoupon will apply a coupon code to a checkout.
+func (s *CheckoutServiceOp) AddCoupon(checkoutID, couponCode string) (Checkout, error) {
+	return s.AddCouponWithContext(context.Background(), checkoutID, couponCode)
+}
+
+// AddCouponWithContext is the context-aware variant of AddCoupon.
+func (s *CheckoutServiceOp) AddCouponWithContext(ctx context.Context, checkoutID, couponCode string) (Checkout, error) {
+	checkoutResponse := GetCheckoutResponse{}
+	jsonBody, err := json.Marshal(checkoutCoupon{CouponCode: couponCode})
+	if err != nil {
+		return checkoutResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("/v3/checkouts/%s/coupons", checkoutID), reqBody)
+	if reqErr != nil {
+		return checkoutResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &checkoutResponse)
+	if jsonErr != nil {
+		return checkoutResponse.Data, jsonErr
+	}
+	return checkoutResponse.Data, nil
+}
+
+// DeleteCoupon will remove a coupon code from a checkout.
+func (s *CheckoutServiceOp) DeleteCoupon(checkoutID, couponCode string) (Checkout, error) {
+	return s.DeleteCouponWithContext(context.Background(), checkoutID, couponCode)
+}
+
+// DeleteCouponWithContext is the context-aware variant of DeleteCoupon.
+func (s *CheckoutServiceOp) DeleteCouponWithContext(ctx context.Context, checkoutID, couponCode string) (Checkout, error) {
+	checkoutResponse := GetCheckoutResponse{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/checkouts/%s/coupons/%s", checkoutID, url.PathEscape(couponCode)), nil)
+	if reqErr != nil {
+		return checkoutResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &checkoutResponse)
+	if jsonErr != nil {
+		return checkoutResponse.Data, jsonErr
+	}
+	return checkoutResponse.Data, nil
+}
+
+// CreateOrder will turn a checkout into an order, with the Incomplete status, and return the order ID.
+// Take the payment with the Payments service to complete the order.
+func (s *CheckoutServiceOp) CreateOrder(checkoutID string) (int, error) {
+	return s.CreateOrderWithContext(context.Background(), checkoutID)
+}
+
+// CreateOrderWithContext is the context-aware variant of CreateOrder.
+func (s *CheckoutServiceOp) CreateOrderWithContext(ctx context.Context, checkoutID string) (int, error) {
+	orderResponse := checkoutOrderResponse{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("/v3/checkouts/%s/orders", checkoutID), nil)
+	if reqErr != nil {
+		return 0, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &orderResponse)
+	if jsonErr != nil {
+		return 0, jsonErr
+	}
+	return orderResponse.Data.ID, nil
+}
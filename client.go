@@ -1,10 +1,14 @@
 package bigcommerce
 
 import (
-	"fmt"
+	"bytes"
+	"context"
+	"encoding/json"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"strings"
+	"time"
 )
 
 // App represents basic app settings
@@ -17,9 +21,31 @@ type App struct {
 // Client is a collection of services that interacts with the BigCommerce API.
 type Client struct {
 	app        App
-	HTTPClient http.Client
-	Webhooks   WebhooksService
-	Storefront StorefrontService
+	HTTPClient *http.Client
+	// RetryPolicy controls retries of transient failures. Set it to nil to
+	// make exactly one attempt per request.
+	RetryPolicy  *RetryPolicy
+	rateLimit    rateLimiter
+	baseURL      string
+	apiHost      string
+	paymentsHost string
+	userAgent    string
+	headers      http.Header
+	timeout      time.Duration
+	logger       Logger
+	Webhooks     WebhooksService
+	Storefront   StorefrontService
+	Content      ContentService
+	Catalog      CatalogService
+	Orders       OrdersService
+	Customers    CustomersService
+	Carts        CartService
+	Checkouts    CheckoutService
+	Payments     PaymentService
+	Channels     ChannelsService
+	PriceLists   PriceListsService
+	Inventory    InventoryService
+	Themes       ThemeService
 }
 
 type Links struct {
@@ -33,7 +59,7 @@ type PaginationResult struct {
 	Count       int64 `json:"count,omitempty"`
 	PerPage     int64 `json:"per_page,omitempty"`
 	CurrentPage int64 `json:"current_page,omitempty"`
-	Totalpages  int64 `json:"total_pages,omitempty`
+	Totalpages  int64 `json:"total_pages,omitempty"`
 	Links       Links `json:"links,omitempty"`
 }
 
@@ -43,13 +69,28 @@ type MetaResult struct {
 
 // NewClient will create a new client instance for interacting with the BigCommerce API.
 func (a App) NewClient(httpClient http.Client) *Client {
-	return client(a, httpClient)
+	return client(a, WithHTTPClient(&httpClient))
 }
 
-func client(app App, httpClient http.Client) *Client {
+// NewClient will create a new client instance for interacting with the BigCommerce API,
+// configured by the given options.
+func NewClient(app App, options ...Option) *Client {
+	return client(app, options...)
+}
+
+func client(app App, options ...Option) *Client {
 	c := &Client{
-		app: app,
+		app:         app,
+		HTTPClient:  http.DefaultClient,
+		RetryPolicy: DefaultRetryPolicy(),
+		userAgent:   defaultUserAgent,
+		headers:     http.Header{},
+	}
+
+	for _, option := range options {
+		option(c)
 	}
+	c.baseURL = c.resolveBaseURL()
 
 	c.Webhooks = &WebhooksServiceOp{client: c}
 
@@ -60,47 +101,220 @@ func client(app App, httpClient http.Client) *Client {
 	c.Storefront.Search = &StorefrontSearchSettingsOp{client: c}
 	c.Storefront.Category = &StorefrontCategorySettingsOp{client: c}
 	c.Storefront.RobotsTxt = &StorefrontRobotsTxtSettingsOp{client: c}
+	c.Storefront.Redirects = &RedirectServiceOp{client: c}
+
+	c.Content = ContentService{}
+	c.Content.WidgetTemplates = &WidgetTemplateServiceOp{client: c}
+	c.Content.Widgets = &WidgetServiceOp{client: c}
+	c.Content.Placements = &PlacementServiceOp{client: c}
+	c.Content.Regions = &RegionServiceOp{client: c}
+	c.Content.Scripts = &ScriptServiceOp{client: c}
+	c.Content.Pages = &PageServiceOp{client: c}
+
+	c.Catalog = CatalogService{}
+	c.Catalog.Products = &ProductServiceOp{client: c}
+	c.Catalog.ProductImages = &ProductImageServiceOp{client: c}
+	c.Catalog.Variants = &VariantServiceOp{client: c}
+	c.Catalog.Options = &ProductOptionServiceOp{client: c}
+	c.Catalog.Modifiers = &ProductModifierServiceOp{client: c}
+	c.Catalog.Categories = &CategoryServiceOp{client: c}
+	c.Catalog.CategoryTrees = &CategoryTreeServiceOp{client: c}
+	c.Catalog.Brands = &BrandServiceOp{client: c}
+
+	c.Orders = OrdersService{}
+	c.Orders.Orders = &OrderServiceOp{client: c}
+	c.Orders.Shipments = &OrderShipmentServiceOp{client: c}
+	c.Orders.Refunds = &OrderRefundServiceOp{client: c}
+	c.Orders.Transactions = &OrderTransactionServiceOp{client: c}
+
+	c.Customers = CustomersService{}
+	c.Customers.Customers = &CustomerServiceOp{client: c}
+	c.Customers.Addresses = &CustomerAddressServiceOp{client: c}
+	c.Customers.Attributes = &CustomerAttributeServiceOp{client: c}
+	c.Customers.AttributeValues = &CustomerAttributeValueServiceOp{client: c}
+	c.Customers.FormFieldValues = &CustomerFormFieldValueServiceOp{client: c}
+	c.Customers.Groups = &CustomerGroupServiceOp{client: c}
+	c.Customers.Segments = &SegmentServiceOp{client: c}
+	c.Customers.ShopperProfiles = &ShopperProfileServiceOp{client: c}
+
+	c.Carts = &CartServiceOp{client: c}
+	c.Checkouts = &CheckoutServiceOp{client: c}
+	c.Payments = &PaymentServiceOp{client: c}
+
+	c.Channels = ChannelsService{}
+	c.Channels.Channels = &ChannelServiceOp{client: c}
+	c.Channels.Listings = &ChannelListingServiceOp{client: c}
+	c.Channels.Sites = &SiteServiceOp{client: c}
+
+	c.PriceLists = PriceListsService{}
+	c.PriceLists.PriceLists = &PriceListServiceOp{client: c}
+	c.PriceLists.Records = &PriceListRecordServiceOp{client: c}
+
+	c.Inventory = InventoryService{}
+	c.Inventory.Stock = &InventoryStockServiceOp{client: c}
+	c.Inventory.Locations = &InventoryLocationServiceOp{client: c}
+
+	c.Themes = &ThemeServiceOp{client: c}
 
 	return c
 }
 
-func (c *Client) newRequest(method, path string, body io.Reader) (*http.Request, error) {
-	url := fmt.Sprintf("https://api.bigcommerce.com/stores/%s%s", c.app.StoreHash, path)
-	req, err := http.NewRequest(method, url, body)
+// newRequest builds a request for path, which is relative to the store's
+// base URL unless it is an absolute URL. header is applied last; when it sets
+// Authorization the store's API credentials are not sent.
+func (c *Client) newRequest(ctx context.Context, method, path string, header http.Header, body io.Reader) (*http.Request, error) {
+	url := c.baseURL + path
+	if strings.HasPrefix(path, "https://") || strings.HasPrefix(path, "http://") {
+		url = path
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
 		return &http.Request{}, err
 	}
 
+	for key, values := range c.headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	req.Header.Set("User-Agent", c.userAgent)
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
-	req.Header.Set("X-Auth-Client", c.app.ClientID)
-	req.Header.Set("X-Auth-Token", c.app.AccessToken)
+	if header.Get("Authorization") == "" {
+		req.Header.Set("X-Auth-Client", c.app.ClientID)
+		req.Header.Set("X-Auth-Token", c.app.AccessToken)
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
 
 	return req, nil
 }
 
 // DoRequest will create a request and return the response.
+// Non-2xx responses are returned as an *APIError.
 func (c *Client) DoRequest(method, path string, reqBody io.Reader) ([]byte, error) {
-	req, err := c.newRequest(method, path, reqBody)
+	return c.DoRequestWithContext(context.Background(), method, path, reqBody)
+}
+
+// DoRequestWithContext will create a request bound to ctx and return the response.
+// Cancelling ctx or exceeding its deadline aborts the in-flight request.
+//
+// When the store's rate limit quota is exhausted the request waits for the
+// window to reset, and 429 responses are retried after the advertised reset time.
+// Other transient failures are retried according to the client's RetryPolicy;
+// the request body is buffered so it can be replayed on every attempt.
+func (c *Client) DoRequestWithContext(ctx context.Context, method, path string, reqBody io.Reader) ([]byte, error) {
+	return c.doRequest(ctx, method, path, nil, reqBody)
+}
+
+// doRequest sends reqBody with the given extra headers, applying the rate
+// limit and retry handling described on DoRequestWithContext.
+func (c *Client) doRequest(ctx context.Context, method, path string, header http.Header, reqBody io.Reader) ([]byte, error) {
+	var payload []byte
+	if reqBody != nil {
+		var err error
+		payload, err = ioutil.ReadAll(reqBody)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rateLimited := 0
+	for attempt := 1; ; attempt++ {
+		if err := c.rateLimit.wait(ctx); err != nil {
+			return nil, withAttempts(err, attempt-1)
+		}
+
+		var body io.Reader
+		if reqBody != nil {
+			body = bytes.NewReader(payload)
+		}
+
+		res, resBody, err := c.send(ctx, method, path, header, body)
+		if err != nil {
+			if ctx.Err() == nil && c.RetryPolicy.shouldRetry(method, attempt-rateLimited, 0, err) {
+				if sleepErr := sleepContext(ctx, c.RetryPolicy.backoff(attempt-rateLimited)); sleepErr != nil {
+					return nil, withAttempts(sleepErr, attempt)
+				}
+				continue
+			}
+			return nil, withAttempts(err, attempt)
+		}
+
+		if res.StatusCode == http.StatusTooManyRequests && rateLimited < maxRateLimitRetries {
+			rateLimited++
+			if err := sleepContext(ctx, retryAfter(res.Header)); err != nil {
+				return nil, withAttempts(err, attempt)
+			}
+			continue
+		}
+
+		if res.StatusCode >= 300 {
+			if c.RetryPolicy.shouldRetry(method, attempt-rateLimited, res.StatusCode, nil) {
+				if err := sleepContext(ctx, c.RetryPolicy.backoff(attempt-rateLimited)); err != nil {
+					return nil, withAttempts(err, attempt)
+				}
+				continue
+			}
+			return nil, withAttempts(newAPIError(res, method, path, resBody), attempt)
+		}
+
+		return resBody, nil
+	}
+}
+
+// RateLimit returns the store's request quota as reported by the most recent response.
+func (c *Client) RateLimit() RateLimit {
+	return c.rateLimit.current()
+}
+
+// send performs a single HTTP round trip and records the rate limit headers.
+func (c *Client) send(ctx context.Context, method, path string, header http.Header, reqBody io.Reader) (*http.Response, []byte, error) {
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	req, err := c.newRequest(ctx, method, path, header, reqBody)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
+	start := time.Now()
 	res, doErr := c.HTTPClient.Do(req)
 	if doErr != nil {
-		return nil, doErr
+		c.logf("%s %s: %v (%s)", method, path, doErr, time.Since(start))
+		return nil, nil, doErr
 	}
 
 	defer res.Body.Close()
 
 	body, readErr := ioutil.ReadAll(res.Body)
 	if readErr != nil {
-		return nil, readErr
+		return res, nil, readErr
 	}
 
-	if res.StatusCode >= 300 {
-		return nil, fmt.Errorf("Received non-2xx status\nstatus code: %d\nbody: %s", res.StatusCode, string(body))
+	c.rateLimit.update(res.Header)
+	c.logf("%s %s: %d (%s)", method, path, res.StatusCode, time.Since(start))
+
+	return res, body, nil
+}
+
+func (c *Client) logf(format string, v ...interface{}) {
+	if c.logger != nil {
+		c.logger.Printf("bigcommerce: "+format, v...)
 	}
+}
 
-	return body, nil
+// unmarshalOptional decodes a response body that may be empty, leaving v
+// untouched when it is. v2 endpoints reply 204 with no body when a list has
+// no items, as does the v3 cart API when the last item is deleted.
+func unmarshalOptional(body []byte, v interface{}) error {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+	return json.Unmarshal(body, v)
 }
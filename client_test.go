@@ -0,0 +1,90 @@
+package bigcommerce
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTestClient returns a client that sends requests to a test server
+// running handler, without retries unless options enable them.
+func newTestClient(t *testing.T, handler http.HandlerFunc, options ...Option) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	options = append([]Option{WithBaseURL(server.URL), WithRetryPolicy(nil)}, options...)
+	return NewClient(App{StoreHash: "store", ClientID: "client", AccessToken: "token"}, options...)
+}
+
+func TestDoRequestRetriesRateLimitedResponses(t *testing.T) {
+	var calls int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("X-Rate-Limit-Time-Reset-Ms", "20")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{}`))
+	})
+
+	start := time.Now()
+	if _, err := client.DoRequest(http.MethodPost, "/v3/widgets", nil); err != nil {
+		t.Fatalf("DoRequest: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("calls = %d, want 2", calls)
+	}
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Errorf("retried after %s, want at least the 20ms reset time", elapsed)
+	}
+}
+
+func TestDoRequestGivesUpOnPersistentRateLimiting(t *testing.T) {
+	var calls int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("X-Rate-Limit-Time-Reset-Ms", "1")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	_, err := client.DoRequest(http.MethodGet, "/v3/widgets", nil)
+	if !IsRateLimited(err) {
+		t.Fatalf("err = %v, want a rate limited APIError", err)
+	}
+	if want := int32(maxRateLimitRetries + 1); calls != want {
+		t.Errorf("calls = %d, want %d", calls, want)
+	}
+}
+
+func TestDoRequestWaitsForExhaustedQuota(t *testing.T) {
+	var calls int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("X-Rate-Limit-Requests-Left", "0")
+		w.Header().Set("X-Rate-Limit-Requests-Quota", "150")
+		w.Header().Set("X-Rate-Limit-Time-Window-Ms", "30000")
+		w.Header().Set("X-Rate-Limit-Time-Reset-Ms", "50")
+		w.Write([]byte(`{}`))
+	})
+
+	if _, err := client.DoRequest(http.MethodGet, "/v3/widgets", nil); err != nil {
+		t.Fatalf("first DoRequest: %v", err)
+	}
+
+	limit := client.RateLimit()
+	if limit.RequestsLeft != 0 || limit.Quota != 150 || limit.Window != 30*time.Second {
+		t.Errorf("RateLimit() = %+v", limit)
+	}
+
+	start := time.Now()
+	if _, err := client.DoRequest(http.MethodGet, "/v3/widgets", nil); err != nil {
+		t.Fatalf("second DoRequest: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("second request sent after %s, want it to wait for the quota reset", elapsed)
+	}
+}
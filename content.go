@@ -0,0 +1,11 @@
+package bigcommerce
+
+// ContentService groups the storefront content services: Page Builder, Script Manager and web pages.
+type ContentService struct {
+	WidgetTemplates WidgetTemplateService
+	Widgets         WidgetService
+	Placements      PlacementService
+	Regions         RegionService
+	Scripts         ScriptService
+	Pages           PageService
+}
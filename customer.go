@@ -0,0 +1,253 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// MaxCustomerBatchSize is the number of customers the API accepts in a single batch.
+const MaxCustomerBatchSize = 10
+
+// Sub-resources that can be requested with ListCustomersOptions.Include.
+const (
+	CustomerIncludeAddresses        = "addresses"
+	CustomerIncludeStoreCredit      = "storecredit"
+	CustomerIncludeAttributes       = "attributes"
+	CustomerIncludeFormFields       = "formfields"
+	CustomerIncludeShopperProfileID = "shopper_profile_id"
+	CustomerIncludeSegmentIDs       = "segment_ids"
+)
+
+// CustomersService groups the customer services.
+type CustomersService struct {
+	Customers       CustomerService
+	Addresses       CustomerAddressService
+	Attributes      CustomerAttributeService
+	AttributeValues CustomerAttributeValueService
+	FormFieldValues CustomerFormFieldValueService
+	Groups          CustomerGroupService
+	Segments        SegmentService
+	ShopperProfiles ShopperProfileService
+}
+
+// CustomerService manages customers. The API only has batch endpoints, so
+// Create, Update and Delete take several customers at once.
+type CustomerService interface {
+	List(...ListCustomersOptions) (ListCustomerResponse, error)
+	ListWithContext(context.Context, ...ListCustomersOptions) (ListCustomerResponse, error)
+	Create([]Customer) ([]Customer, error)
+	CreateWithContext(context.Context, []Customer) ([]Customer, error)
+	Update([]Customer) ([]Customer, error)
+	UpdateWithContext(context.Context, []Customer) ([]Customer, error)
+	Delete([]int) error
+	DeleteWithContext(context.Context, []int) error
+	Iterate(context.Context, IteratorOptions, ...ListCustomersOptions) *Iterator
+}
+
+type ListCustomerResponse struct {
+	Data []Customer `json:"data"`
+	Meta MetaResult `json:"meta"`
+}
+
+// Customer structure.
+// Addresses, Attributes and FormFields are only set on List when included,
+// and can be sent on Create.
+type Customer struct {
+	ID                                      int                      `json:"id,omitempty"`
+	Email                                   string                   `json:"email,omitempty"`
+	FirstName                               string                   `json:"first_name,omitempty"`
+	LastName                                string                   `json:"last_name,omitempty"`
+	Company                                 string                   `json:"company,omitempty"`
+	Phone                                   string                   `json:"phone,omitempty"`
+	Notes                                   string                   `json:"notes,omitempty"`
+	RegistrationIPAddress                   string                   `json:"registration_ip_address,omitempty"`
+	TaxExemptCategory                       string                   `json:"tax_exempt_category,omitempty"`
+	CustomerGroupID                         *int                     `json:"customer_group_id,omitempty"`
+	AcceptsProductReviewAbandonedCartEmails *bool                    `json:"accepts_product_review_abandoned_cart_emails,omitempty"`
+	TriggerAccountCreatedNotification       *bool                    `json:"trigger_account_created_notification,omitempty"`
+	OriginChannelID                         int                      `json:"origin_channel_id,omitempty"`
+	ChannelIDs                              []int                    `json:"channel_ids,omitempty"`
+	Authentication                          *CustomerAuthentication  `json:"authentication,omitempty"`
+	StoreCreditAmounts                      []StoreCredit            `json:"store_credit_amounts,omitempty"`
+	Addresses                               []CustomerAddress        `json:"addresses,omitempty"`
+	Attributes                              []CustomerAttributeValue `json:"attributes,omitempty"`
+	FormFields                              []CustomerFormFieldValue `json:"form_fields,omitempty"`
+	AddressCount                            int                      `json:"address_count,omitempty"`
+	AttributeCount                          int                      `json:"attribute_count,omitempty"`
+	ShopperProfileID                        string                   `json:"shopper_profile_id,omitempty"`
+	SegmentIDs                              []string                 `json:"segment_ids,omitempty"`
+	DateCreated                             string                   `json:"date_created,omitempty"`
+	DateModified                            string                   `json:"date_modified,omitempty"`
+}
+
+// CustomerAuthentication sets the password of a customer.
+type CustomerAuthentication struct {
+	ForcePasswordReset bool   `json:"force_password_reset,omitempty"`
+	NewPassword        string `json:"new_password,omitempty"`
+}
+
+// StoreCredit is an amount of store credit held by a customer.
+type StoreCredit struct {
+	Amount float64 `json:"amount"`
+}
+
+// ListCustomersOptions filters the customers returned by List.
+type ListCustomersOptions struct {
+	ListOptions
+	IDIn                    []int     `url:"id:in,omitempty"`
+	CompanyIn               []string  `url:"company:in,omitempty"`
+	CustomerGroupIDIn       []int     `url:"customer_group_id:in,omitempty"`
+	EmailIn                 []string  `url:"email:in,omitempty"`
+	NameIn                  []string  `url:"name:in,omitempty"`
+	NameLike                []string  `url:"name:like,omitempty"`
+	RegistrationIPAddressIn []string  `url:"registration_ip_address:in,omitempty"`
+	DateCreated             time.Time `url:"date_created,omitempty"`
+	DateCreatedMin          time.Time `url:"date_created:min,omitempty"`
+	DateCreatedMax          time.Time `url:"date_created:max,omitempty"`
+	DateModified            time.Time `url:"date_modified,omitempty"`
+	DateModifiedMin         time.Time `url:"date_modified:min,omitempty"`
+	DateModifiedMax         time.Time `url:"date_modified:max,omitempty"`
+	Include                 []string  `url:"include,omitempty"`
+	Sort                    string    `url:"sort,omitempty"`
+}
+
+type CustomerServiceOp struct {
+	client *Client
+}
+
+// List will return a page of customers matching the options.
+func (s *CustomerServiceOp) List(options ...ListCustomersOptions) (ListCustomerResponse, error) {
+	return s.ListWithContext(context.Background(), options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *CustomerServiceOp) ListWithContext(ctx context.Context, options ...ListCustomersOptions) (ListCustomerResponse, error) {
+	listResult := ListCustomerResponse{}
+
+	var listOptions ListCustomersOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/customers", listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// Create will create up to MaxCustomerBatchSize customers in a single request.
+func (s *CustomerServiceOp) Create(customers []Customer) ([]Customer, error) {
+	return s.CreateWithContext(context.Background(), customers)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *CustomerServiceOp) CreateWithContext(ctx context.Context, customers []Customer) ([]Customer, error) {
+	listResult := ListCustomerResponse{}
+	if len(customers) > MaxCustomerBatchSize {
+		return listResult.Data, fmt.Errorf("bigcommerce: cannot create %d customers in one batch, the limit is %d", len(customers), MaxCustomerBatchSize)
+	}
+
+	jsonBody, err := json.Marshal(customers)
+	if err != nil {
+		return listResult.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, "/v3/customers", reqBody)
+	if reqErr != nil {
+		return listResult.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult.Data, jsonErr
+	}
+	return listResult.Data, nil
+}
+
+// Update will update up to MaxCustomerBatchSize customers in a single request.
+// Every customer must have its ID set.
+func (s *CustomerServiceOp) Update(customers []Customer) ([]Customer, error) {
+	return s.UpdateWithContext(context.Background(), customers)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *CustomerServiceOp) UpdateWithContext(ctx context.Context, customers []Customer) ([]Customer, error) {
+	listResult := ListCustomerResponse{}
+	if len(customers) > MaxCustomerBatchSize {
+		return listResult.Data, fmt.Errorf("bigcommerce: cannot update %d customers in one batch, the limit is %d", len(customers), MaxCustomerBatchSize)
+	}
+
+	jsonBody, err := json.Marshal(customers)
+	if err != nil {
+		return listResult.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, "/v3/customers", reqBody)
+	if reqErr != nil {
+		return listResult.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult.Data, jsonErr
+	}
+	return listResult.Data, nil
+}
+
+// Delete will delete every customer with one of the provided IDs.
+func (s *CustomerServiceOp) Delete(ids []int) error {
+	return s.DeleteWithContext(context.Background(), ids)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *CustomerServiceOp) DeleteWithContext(ctx context.Context, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	path, err := addQuery("/v3/customers", ListCustomersOptions{IDIn: ids})
+	if err != nil {
+		return err
+	}
+
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, path, nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// Iterate will walk every customer matching the options, fetching further pages as needed.
+func (s *CustomerServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, options ...ListCustomersOptions) *Iterator {
+	var listOptions ListCustomersOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListWithContext(ctx, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
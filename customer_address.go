@@ -0,0 +1,195 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// MaxCustomerAddressBatchSize is the number of addresses the API accepts in a single batch.
+const MaxCustomerAddressBatchSize = 10
+
+type CustomerAddressService interface {
+	List(...ListCustomerAddressesOptions) (ListCustomerAddressResponse, error)
+	ListWithContext(context.Context, ...ListCustomerAddressesOptions) (ListCustomerAddressResponse, error)
+	Create([]CustomerAddress) ([]CustomerAddress, error)
+	CreateWithContext(context.Context, []CustomerAddress) ([]CustomerAddress, error)
+	Update([]CustomerAddress) ([]CustomerAddress, error)
+	UpdateWithContext(context.Context, []CustomerAddress) ([]CustomerAddress, error)
+	Delete([]int) error
+	DeleteWithContext(context.Context, []int) error
+	Iterate(context.Context, IteratorOptions, ...ListCustomerAddressesOptions) *Iterator
+}
+
+type ListCustomerAddressResponse struct {
+	Data []CustomerAddress `json:"data"`
+	Meta MetaResult        `json:"meta"`
+}
+
+// CustomerAddress structure.
+type CustomerAddress struct {
+	ID              int                      `json:"id,omitempty"`
+	CustomerID      int                      `json:"customer_id,omitempty"`
+	FirstName       string                   `json:"first_name,omitempty"`
+	LastName        string                   `json:"last_name,omitempty"`
+	Company         string                   `json:"company,omitempty"`
+	Address1        string                   `json:"address1,omitempty"`
+	Address2        string                   `json:"address2,omitempty"`
+	City            string                   `json:"city,omitempty"`
+	StateOrProvince string                   `json:"state_or_province,omitempty"`
+	PostalCode      string                   `json:"postal_code,omitempty"`
+	Country         string                   `json:"country,omitempty"`
+	CountryCode     string                   `json:"country_code,omitempty"`
+	Phone           string                   `json:"phone,omitempty"`
+	AddressType     string                   `json:"address_type,omitempty"`
+	FormFields      []CustomerFormFieldValue `json:"form_fields,omitempty"`
+}
+
+// ListCustomerAddressesOptions filters the addresses returned by List.
+type ListCustomerAddressesOptions struct {
+	ListOptions
+	IDIn         []int    `url:"id:in,omitempty"`
+	CustomerIDIn []int    `url:"customer_id:in,omitempty"`
+	CompanyIn    []string `url:"company:in,omitempty"`
+	NameIn       []string `url:"name:in,omitempty"`
+	Include      []string `url:"include,omitempty"`
+}
+
+type CustomerAddressServiceOp struct {
+	client *Client
+}
+
+// List will return a page of addresses matching the options.
+func (s *CustomerAddressServiceOp) List(options ...ListCustomerAddressesOptions) (ListCustomerAddressResponse, error) {
+	return s.ListWithContext(context.Background(), options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *CustomerAddressServiceOp) ListWithContext(ctx context.Context, options ...ListCustomerAddressesOptions) (ListCustomerAddressResponse, error) {
+	listResult := ListCustomerAddressResponse{}
+
+	var listOptions ListCustomerAddressesOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/customers/addresses", listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// Create will create up to MaxCustomerAddressBatchSize addresses in a single request.
+func (s *CustomerAddressServiceOp) Create(addresses []CustomerAddress) ([]CustomerAddress, error) {
+	return s.CreateWithContext(context.Background(), addresses)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *CustomerAddressServiceOp) CreateWithContext(ctx context.Context, addresses []CustomerAddress) ([]CustomerAddress, error) {
+	listResult := ListCustomerAddressResponse{}
+	if len(addresses) > MaxCustomerAddressBatchSize {
+		return listResult.Data, fmt.Errorf("bigcommerce: cannot create %d addresses in one batch, the limit is %d", len(addresses), MaxCustomerAddressBatchSize)
+	}
+
+	jsonBody, err := json.Marshal(addresses)
+	if err != nil {
+		return listResult.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, "/v3/customers/addresses", reqBody)
+	if reqErr != nil {
+		return listResult.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult.Data, jsonErr
+	}
+	return listResult.Data, nil
+}
+
+// Update will update up to MaxCustomerAddressBatchSize addresses in a single request.
+// Every address must have its ID set.
+func (s *CustomerAddressServiceOp) Update(addresses []CustomerAddress) ([]CustomerAddress, error) {
+	return s.UpdateWithContext(context.Background(), addresses)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *CustomerAddressServiceOp) UpdateWithContext(ctx context.Context, addresses []CustomerAddress) ([]CustomerAddress, error) {
+	listResult := ListCustomerAddressResponse{}
+	if len(addresses) > MaxCustomerAddressBatchSize {
+		return listResult.Data, fmt.Errorf("bigcommerce: cannot update %d addresses in one batch, the limit is %d", len(addresses), MaxCustomerAddressBatchSize)
+	}
+
+	jsonBody, err := json.Marshal(addresses)
+	if err != nil {
+		return listResult.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, "/v3/customers/addresses", reqBody)
+	if reqErr != nil {
+		return listResult.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult.Data, jsonErr
+	}
+	return listResult.Data, nil
+}
+
+// Delete will delete every address with one of the provided IDs.
+func (s *CustomerAddressServiceOp) Delete(ids []int) error {
+	return s.DeleteWithContext(context.Background(), ids)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *CustomerAddressServiceOp) DeleteWithContext(ctx context.Context, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	path, err := addQuery("/v3/customers/addresses", ListCustomerAddressesOptions{IDIn: ids})
+	if err != nil {
+		return err
+	}
+
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, path, nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// Iterate will walk every address matching the options, fetching further pages as needed.
+func (s *CustomerAddressServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, options ...ListCustomerAddressesOptions) *Iterator {
+	var listOptions ListCustomerAddressesOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListWithContext(ctx, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
@@ -0,0 +1,338 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// MaxCustomerAttributeBatchSize is the number of attributes, or attribute values,
+// the API accepts in a single batch.
+const MaxCustomerAttributeBatchSize = 10
+
+// Customer attribute types, as used by CustomerAttribute.Type.
+const (
+	CustomerAttributeTypeString = "string"
+	CustomerAttributeTypeNumber = "number"
+	CustomerAttributeTypeDate   = "date"
+)
+
+type CustomerAttributeService interface {
+	List(...ListCustomerAttributesOptions) (ListCustomerAttributeResponse, error)
+	ListWithContext(context.Context, ...ListCustomerAttributesOptions) (ListCustomerAttributeResponse, error)
+	Create([]CustomerAttribute) ([]CustomerAttribute, error)
+	CreateWithContext(context.Context, []CustomerAttribute) ([]CustomerAttribute, error)
+	Update([]CustomerAttribute) ([]CustomerAttribute, error)
+	UpdateWithContext(context.Context, []CustomerAttribute) ([]CustomerAttribute, error)
+	Delete([]int) error
+	DeleteWithContext(context.Context, []int) error
+	Iterate(context.Context, IteratorOptions, ...ListCustomerAttributesOptions) *Iterator
+}
+
+type ListCustomerAttributeResponse struct {
+	Data []CustomerAttribute `json:"data"`
+	Meta MetaResult          `json:"meta"`
+}
+
+type ListCustomerAttributeValueResponse struct {
+	Data []CustomerAttributeValue `json:"data"`
+	Meta MetaResult               `json:"meta"`
+}
+
+// CustomerAttribute structure.
+type CustomerAttribute struct {
+	ID           int    `json:"id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Type         string `json:"type,omitempty"`
+	DateCreated  string `json:"date_created,omitempty"`
+	DateModified string `json:"date_modified,omitempty"`
+}
+
+// CustomerAttributeValue is the value of an attribute for a customer.
+// Values are always sent as strings, whatever the attribute type.
+type CustomerAttributeValue struct {
+	ID           int    `json:"id,omitempty"`
+	AttributeID  int    `json:"attribute_id,omitempty"`
+	CustomerID   int    `json:"customer_id,omitempty"`
+	Value        string `json:"value"`
+	DateCreated  string `json:"date_created,omitempty"`
+	DateModified string `json:"date_modified,omitempty"`
+}
+
+// ListCustomerAttributesOptions filters the attributes returned by List.
+type ListCustomerAttributesOptions struct {
+	ListOptions
+	IDIn           []int     `url:"id:in,omitempty"`
+	Name           string    `url:"name,omitempty"`
+	NameLike       string    `url:"name:like,omitempty"`
+	Type           string    `url:"type,omitempty"`
+	DateCreated    time.Time `url:"date_created,omitempty"`
+	DateCreatedMin time.Time `url:"date_created:min,omitempty"`
+	DateCreatedMax time.Time `url:"date_created:max,omitempty"`
+}
+
+// ListCustomerAttributeValuesOptions filters the attribute values returned by List.
+type ListCustomerAttributeValuesOptions struct {
+	ListOptions
+	IDIn          []int    `url:"id:in,omitempty"`
+	CustomerIDIn  []int    `url:"customer_id:in,omitempty"`
+	AttributeIDIn []int    `url:"attribute_id:in,omitempty"`
+	NameIn        []string `url:"name:in,omitempty"`
+}
+
+type CustomerAttributeServiceOp struct {
+	client *Client
+}
+
+// List will return a page of attributes matching the options.
+func (s *CustomerAttributeServiceOp) List(options ...ListCustomerAttributesOptions) (ListCustomerAttributeResponse, error) {
+	return s.ListWithContext(context.Background(), options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *CustomerAttributeServiceOp) ListWithContext(ctx context.Context, options ...ListCustomerAttributesOptions) (ListCustomerAttributeResponse, error) {
+	listResult := ListCustomerAttributeResponse{}
+
+	var listOptions ListCustomerAttributesOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/customers/attributes", listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// Create will create up to MaxCustomerAttributeBatchSize attributes in a single request.
+func (s *CustomerAttributeServiceOp) Create(attributes []CustomerAttribute) ([]CustomerAttribute, error) {
+	return s.CreateWithContext(context.Background(), attributes)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *CustomerAttributeServiceOp) CreateWithContext(ctx context.Context, attributes []CustomerAttribute) ([]CustomerAttribute, error) {
+	listResult := ListCustomerAttributeResponse{}
+	if len(attributes) > MaxCustomerAttributeBatchSize {
+		return listResult.Data, fmt.Errorf("bigcommerce: cannot create %d attributes in one batch, the limit is %d", len(attributes), MaxCustomerAttributeBatchSize)
+	}
+
+	jsonBody, err := json.Marshal(attributes)
+	if err != nil {
+		return listResult.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, "/v3/customers/attributes", reqBody)
+	if reqErr != nil {
+		return listResult.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult.Data, jsonErr
+	}
+	return listResult.Data, nil
+}
+
+// Update will update up to MaxCustomerAttributeBatchSize attributes in a single request.
+// Every attribute must have its ID set.
+func (s *CustomerAttributeServiceOp) Update(attributes []CustomerAttribute) ([]CustomerAttribute, error) {
+	return s.UpdateWithContext(context.Background(), attributes)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *CustomerAttributeServiceOp) UpdateWithContext(ctx context.Context, attributes []CustomerAttribute) ([]CustomerAttribute, error) {
+	listResult := ListCustomerAttributeResponse{}
+	if len(attributes) > MaxCustomerAttributeBatchSize {
+		return listResult.Data, fmt.Errorf("bigcommerce: cannot update %d attributes in one batch, the limit is %d", len(attributes), MaxCustomerAttributeBatchSize)
+	}
+
+	jsonBody, err := json.Marshal(attributes)
+	if err != nil {
+		return listResult.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, "/v3/customers/attributes", reqBody)
+	if reqErr != nil {
+		return listResult.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult.Data, jsonErr
+	}
+	return listResult.Data, nil
+}
+
+// Delete will delete every attribute with one of the provided IDs.
+func (s *CustomerAttributeServiceOp) Delete(ids []int) error {
+	return s.DeleteWithContext(context.Background(), ids)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *CustomerAttributeServiceOp) DeleteWithContext(ctx context.Context, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	path, err := addQuery("/v3/customers/attributes", ListCustomerAttributesOptions{IDIn: ids})
+	if err != nil {
+		return err
+	}
+
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, path, nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// Iterate will walk every attribute matching the options, fetching further pages as needed.
+func (s *CustomerAttributeServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, options ...ListCustomerAttributesOptions) *Iterator {
+	var listOptions ListCustomerAttributesOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListWithContext(ctx, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
+
+type CustomerAttributeValueService interface {
+	List(...ListCustomerAttributeValuesOptions) (ListCustomerAttributeValueResponse, error)
+	ListWithContext(context.Context, ...ListCustomerAttributeValuesOptions) (ListCustomerAttributeValueResponse, error)
+	Upsert([]CustomerAttributeValue) ([]CustomerAttributeValue, error)
+	UpsertWithContext(context.Context, []CustomerAttributeValue) ([]CustomerAttributeValue, error)
+	Delete([]int) error
+	DeleteWithContext(context.Context, []int) error
+	Iterate(context.Context, IteratorOptions, ...ListCustomerAttributeValuesOptions) *Iterator
+}
+
+type CustomerAttributeValueServiceOp struct {
+	client *Client
+}
+
+// List will return a page of attribute values matching the options.
+func (s *CustomerAttributeValueServiceOp) List(options ...ListCustomerAttributeValuesOptions) (ListCustomerAttributeValueResponse, error) {
+	return s.ListWithContext(context.Background(), options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *CustomerAttributeValueServiceOp) ListWithContext(ctx context.Context, options ...ListCustomerAttributeValuesOptions) (ListCustomerAttributeValueResponse, error) {
+	listResult := ListCustomerAttributeValueResponse{}
+
+	var listOptions ListCustomerAttributeValuesOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/customers/attribute-values", listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// Upsert will create or update up to MaxCustomerAttributeBatchSize attribute values in a single request.
+func (s *CustomerAttributeValueServiceOp) Upsert(values []CustomerAttributeValue) ([]CustomerAttributeValue, error) {
+	return s.UpsertWithContext(context.Background(), values)
+}
+
+// UpsertWithContext is the context-aware variant of Upsert.
+func (s *CustomerAttributeValueServiceOp) UpsertWithContext(ctx context.Context, values []CustomerAttributeValue) ([]CustomerAttributeValue, error) {
+	listResult := ListCustomerAttributeValueResponse{}
+	if len(values) > MaxCustomerAttributeBatchSize {
+		return listResult.Data, fmt.Errorf("bigcommerce: cannot upsert %d attribute values in one batch, the limit is %d", len(values), MaxCustomerAttributeBatchSize)
+	}
+
+	jsonBody, err := json.Marshal(values)
+	if err != nil {
+		return listResult.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, "/v3/customers/attribute-values", reqBody)
+	if reqErr != nil {
+		return listResult.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult.Data, jsonErr
+	}
+	return listResult.Data, nil
+}
+
+// Delete will delete every attribute value with one of the provided IDs.
+func (s *CustomerAttributeValueServiceOp) Delete(ids []int) error {
+	return s.DeleteWithContext(context.Background(), ids)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *CustomerAttributeValueServiceOp) DeleteWithContext(ctx context.Context, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	path, err := addQuery("/v3/customers/attribute-values", ListCustomerAttributeValuesOptions{IDIn: ids})
+	if err != nil {
+		return err
+	}
+
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, path, nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// Iterate will walk every attribute value matching the options, fetching further pages as needed.
+func (s *CustomerAttributeValueServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, options ...ListCustomerAttributeValuesOptions) *Iterator {
+	var listOptions ListCustomerAttributeValuesOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListWithContext(ctx, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
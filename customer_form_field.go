@@ -0,0 +1,129 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// MaxCustomerFormFieldValueBatchSize is the number of form field values the API
+// accepts in a single batch.
+const MaxCustomerFormFieldValueBatchSize = 10
+
+type CustomerFormFieldValueService interface {
+	List(...ListCustomerFormFieldValuesOptions) (ListCustomerFormFieldValueResponse, error)
+	ListWithContext(context.Context, ...ListCustomerFormFieldValuesOptions) (ListCustomerFormFieldValueResponse, error)
+	Upsert([]CustomerFormFieldValue) ([]CustomerFormFieldValue, error)
+	UpsertWithContext(context.Context, []CustomerFormFieldValue) ([]CustomerFormFieldValue, error)
+	Iterate(context.Context, IteratorOptions, ...ListCustomerFormFieldValuesOptions) *Iterator
+}
+
+type ListCustomerFormFieldValueResponse struct {
+	Data []CustomerFormFieldValue `json:"data"`
+	Meta MetaResult               `json:"meta"`
+}
+
+// CustomerFormFieldValue is the value of a custom form field of a customer
+// (CustomerID set) or of a customer address (AddressID set).
+// Value is a string, a number or a list of strings depending on the field type.
+type CustomerFormFieldValue struct {
+	Name       string      `json:"name"`
+	Value      interface{} `json:"value"`
+	CustomerID int         `json:"customer_id,omitempty"`
+	AddressID  int         `json:"address_id,omitempty"`
+}
+
+// ListCustomerFormFieldValuesOptions filters the form field values returned by List.
+type ListCustomerFormFieldValuesOptions struct {
+	ListOptions
+	CustomerID int    `url:"customer_id,omitempty"`
+	AddressID  int    `url:"address_id,omitempty"`
+	FieldName  string `url:"field_name,omitempty"`
+	FieldType  string `url:"field_type,omitempty"`
+}
+
+type CustomerFormFieldValueServiceOp struct {
+	client *Client
+}
+
+// List will return a page of form field values matching the options.
+func (s *CustomerFormFieldValueServiceOp) List(options ...ListCustomerFormFieldValuesOptions) (ListCustomerFormFieldValueResponse, error) {
+	return s.ListWithContext(context.Background(), options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *CustomerFormFieldValueServiceOp) ListWithContext(ctx context.Context, options ...ListCustomerFormFieldValuesOptions) (ListCustomerFormFieldValueResponse, error) {
+	listResult := ListCustomerFormFieldValueResponse{}
+
+	var listOptions ListCustomerFormFieldValuesOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/customers/form-field-values", listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// Upsert will create or update up to MaxCustomerFormFieldValueBatchSize form field values in a single request.
+func (s *CustomerFormFieldValueServiceOp) Upsert(values []CustomerFormFieldValue) ([]CustomerFormFieldValue, error) {
+	return s.UpsertWithContext(context.Background(), values)
+}
+
+// UpsertWithContext is the context-aware variant of Upsert.
+func (s *CustomerFormFieldValueServiceOp) UpsertWithContext(ctx context.Context, values []CustomerFormFieldValue) ([]CustomerFormFieldValue, error) {
+	listResult := ListCustomerFormFieldValueResponse{}
+	if len(values) > MaxCustomerFormFieldValueBatchSize {
+		return listResult.Data, fmt.Errorf("bigcommerce: cannot upsert %d form field values in one batch, the limit is %d", len(values), MaxCustomerFormFieldValueBatchSize)
+	}
+
+	jsonBody, err := json.Marshal(values)
+	if err != nil {
+		return listResult.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, "/v3/customers/form-field-values", reqBody)
+	if reqErr != nil {
+		return listResult.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult.Data, jsonErr
+	}
+	return listResult.Data, nil
+}
+
+// Iterate will walk every form field value matching the options, fetching further pages as needed.
+func (s *CustomerFormFieldValueServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, options ...ListCustomerFormFieldValuesOptions) *Iterator {
+	var listOptions ListCustomerFormFieldValuesOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListWithContext(ctx, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
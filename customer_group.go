@@ -0,0 +1,283 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Category access types, as used by CategoryAccess.Type.
+const (
+	CategoryAccessAll      = "all"
+	CategoryAccessSpecific = "specific"
+	CategoryAccessNone     = "none"
+)
+
+// Discount rule types and methods, as used by DiscountRule.
+const (
+	DiscountRuleTypeAll       = "all"
+	DiscountRuleTypeCategory  = "category"
+	DiscountRuleTypeProduct   = "product"
+	DiscountRuleTypePriceList = "price_list"
+
+	DiscountMethodPercent = "percent"
+	DiscountMethodFixed   = "fixed"
+	DiscountMethodPrice   = "price"
+)
+
+type CustomerGroupService interface {
+	Get(int) (CustomerGroup, error)
+	GetWithContext(context.Context, int) (CustomerGroup, error)
+	List(...ListCustomerGroupsOptions) ([]CustomerGroup, error)
+	ListWithContext(context.Context, ...ListCustomerGroupsOptions) ([]CustomerGroup, error)
+	Count() (int, error)
+	CountWithContext(context.Context) (int, error)
+	Create(CustomerGroup) (CustomerGroup, error)
+	CreateWithContext(context.Context, CustomerGroup) (CustomerGroup, error)
+	Update(CustomerGroup) (CustomerGroup, error)
+	UpdateWithContext(context.Context, CustomerGroup) (CustomerGroup, error)
+	Delete(int) error
+	DeleteWithContext(context.Context, int) error
+	AssignCustomers(int, []int) error
+	AssignCustomersWithContext(context.Context, int, []int) error
+	Iterate(context.Context, IteratorOptions, ...ListCustomerGroupsOptions) *Iterator
+}
+
+// CustomerGroup structure.
+type CustomerGroup struct {
+	ID               int             `json:"id,omitempty"`
+	Name             string          `json:"name,omitempty"`
+	IsDefault        *bool           `json:"is_default,omitempty"`
+	IsGroupForGuests *bool           `json:"is_group_for_guests,omitempty"`
+	CategoryAccess   *CategoryAccess `json:"category_access,omitempty"`
+	DiscountRules    []DiscountRule  `json:"discount_rules,omitempty"`
+	DateCreated      string          `json:"date_created,omitempty"`
+	DateModified     string          `json:"date_modified,omitempty"`
+}
+
+// CategoryAccess controls which categories the customers of a group can see.
+// Categories is only used with CategoryAccessSpecific.
+type CategoryAccess struct {
+	Type       string `json:"type"`
+	Categories []int  `json:"categories,omitempty"`
+}
+
+// DiscountRule is a discount given to the customers of a group.
+// CategoryID, ProductID or PriceListID is set depending on Type.
+type DiscountRule struct {
+	Type        string  `json:"type"`
+	Method      string  `json:"method,omitempty"`
+	Amount      Decimal `json:"amount,omitempty"`
+	CategoryID  int     `json:"category_id,omitempty"`
+	ProductID   int     `json:"product_id,omitempty"`
+	PriceListID int     `json:"price_list_id,omitempty"`
+}
+
+// ListCustomerGroupsOptions filters the customer groups returned by List.
+type ListCustomerGroupsOptions struct {
+	ListOptions
+	Name             string    `url:"name,omitempty"`
+	NameLike         string    `url:"name:like,omitempty"`
+	IsDefault        *bool     `url:"is_default,omitempty"`
+	IsGroupForGuests *bool     `url:"is_group_for_guests,omitempty"`
+	DateCreated      time.Time `url:"date_created,omitempty"`
+	DateCreatedMin   time.Time `url:"date_created:min,omitempty"`
+	DateCreatedMax   time.Time `url:"date_created:max,omitempty"`
+	DateModified     time.Time `url:"date_modified,omitempty"`
+	DateModifiedMin  time.Time `url:"date_modified:min,omitempty"`
+	DateModifiedMax  time.Time `url:"date_modified:max,omitempty"`
+}
+
+type customerGroupCount struct {
+	Count int `json:"count"`
+}
+
+type CustomerGroupServiceOp struct {
+	client *Client
+}
+
+// Get will fetch a single customer group by the provided ID.
+func (s *CustomerGroupServiceOp) Get(id int) (CustomerGroup, error) {
+	return s.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *CustomerGroupServiceOp) GetWithContext(ctx context.Context, id int) (CustomerGroup, error) {
+	group := CustomerGroup{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v2/customer_groups/%d", id), nil)
+	if reqErr != nil {
+		return group, reqErr
+	}
+
+	jsonErr := unmarshalOptional(body, &group)
+	if jsonErr != nil {
+		return group, jsonErr
+	}
+	return group, nil
+}
+
+// List will return a page of customer groups matching the options.
+func (s *CustomerGroupServiceOp) List(options ...ListCustomerGroupsOptions) ([]CustomerGroup, error) {
+	return s.ListWithContext(context.Background(), options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *CustomerGroupServiceOp) ListWithContext(ctx context.Context, options ...ListCustomerGroupsOptions) ([]CustomerGroup, error) {
+	groups := []CustomerGroup{}
+
+	var listOptions ListCustomerGroupsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v2/customer_groups", listOptions)
+	if err != nil {
+		return groups, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return groups, reqErr
+	}
+
+	jsonErr := unmarshalOptional(body, &groups)
+	if jsonErr != nil {
+		return groups, jsonErr
+	}
+	return groups, nil
+}
+
+// Count will return the number of customer groups.
+func (s *CustomerGroupServiceOp) Count() (int, error) {
+	return s.CountWithContext(context.Background())
+}
+
+// CountWithContext is the context-aware variant of Count.
+func (s *CustomerGroupServiceOp) CountWithContext(ctx context.Context) (int, error) {
+	count := customerGroupCount{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, "/v2/customer_groups/count", nil)
+	if reqErr != nil {
+		return 0, reqErr
+	}
+
+	jsonErr := unmarshalOptional(body, &count)
+	if jsonErr != nil {
+		return 0, jsonErr
+	}
+	return count.Count, nil
+}
+
+// Create will create a new customer group.
+// The only field required on a customer group is: Name
+func (s *CustomerGroupServiceOp) Create(group CustomerGroup) (CustomerGroup, error) {
+	return s.CreateWithContext(context.Background(), group)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *CustomerGroupServiceOp) CreateWithContext(ctx context.Context, group CustomerGroup) (CustomerGroup, error) {
+	created := CustomerGroup{}
+	jsonBody, err := json.Marshal(group)
+	if err != nil {
+		return created, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, "/v2/customer_groups", reqBody)
+	if reqErr != nil {
+		return created, reqErr
+	}
+
+	jsonErr := unmarshalOptional(body, &created)
+	if jsonErr != nil {
+		return created, jsonErr
+	}
+	return created, nil
+}
+
+// Update will update an existing customer group.
+// Setting DiscountRules replaces every rule of the group.
+func (s *CustomerGroupServiceOp) Update(group CustomerGroup) (CustomerGroup, error) {
+	return s.UpdateWithContext(context.Background(), group)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *CustomerGroupServiceOp) UpdateWithContext(ctx context.Context, group CustomerGroup) (CustomerGroup, error) {
+	updated := CustomerGroup{}
+	jsonBody, err := json.Marshal(group)
+	if err != nil {
+		return updated, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v2/customer_groups/%d", group.ID), reqBody)
+	if reqErr != nil {
+		return updated, reqErr
+	}
+
+	jsonErr := unmarshalOptional(body, &updated)
+	if jsonErr != nil {
+		return updated, jsonErr
+	}
+	return updated, nil
+}
+
+// Delete will delete a customer group by the provided ID.
+// Customers in the group are moved to no group.
+func (s *CustomerGroupServiceOp) Delete(id int) error {
+	return s.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *CustomerGroupServiceOp) DeleteWithContext(ctx context.Context, id int) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v2/customer_groups/%d", id), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// AssignCustomers will move the customers with the provided IDs into a group,
+// updating them MaxCustomerBatchSize at a time.
+func (s *CustomerGroupServiceOp) AssignCustomers(groupID int, customerIDs []int) error {
+	return s.AssignCustomersWithContext(context.Background(), groupID, customerIDs)
+}
+
+// AssignCustomersWithContext is the context-aware variant of AssignCustomers.
+func (s *CustomerGroupServiceOp) AssignCustomersWithContext(ctx context.Context, groupID int, customerIDs []int) error {
+	for start := 0; start < len(customerIDs); start += MaxCustomerBatchSize {
+		end := start + MaxCustomerBatchSize
+		if end > len(customerIDs) {
+			end = len(customerIDs)
+		}
+
+		customers := make([]Customer, 0, end-start)
+		for _, id := range customerIDs[start:end] {
+			customers = append(customers, Customer{ID: id, CustomerGroupID: Int(groupID)})
+		}
+		if _, err := s.client.Customers.Customers.UpdateWithContext(ctx, customers); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Iterate will walk every customer group matching the options, fetching further pages as needed.
+func (s *CustomerGroupServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, options ...ListCustomerGroupsOptions) *Iterator {
+	var listOptions ListCustomerGroupsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		items, err := s.ListWithContext(ctx, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return items, v2Page{count: len(items), limit: limit}, nil
+	}, iteratorOptions)
+}
@@ -0,0 +1,149 @@
+package bigcommerce
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// APIError is returned when the BigCommerce API responds with a non-2xx status.
+// Use errors.As to inspect it, or one of the IsXxx helpers for common cases.
+type APIError struct {
+	StatusCode int
+	Method     string
+	Path       string
+	Title      string
+	Type       string
+	Detail     string
+	// Errors holds per-field validation messages from the v3 error envelope,
+	// or the messages of a v2 error list keyed by their position.
+	Errors map[string]string
+	Header http.Header
+	Body   []byte
+}
+
+type apiErrorV2 struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("bigcommerce: %s %s: %d %s", e.Method, e.Path, e.StatusCode, http.StatusText(e.StatusCode))
+	if e.Title != "" {
+		msg += ": " + e.Title
+	}
+	if e.Detail != "" {
+		msg += " (" + e.Detail + ")"
+	}
+	if len(e.Errors) > 0 {
+		keys := make([]string, 0, len(e.Errors))
+		for k := range e.Errors {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		fields := make([]string, 0, len(keys))
+		for _, k := range keys {
+			fields = append(fields, fmt.Sprintf("%s: %s", k, e.Errors[k]))
+		}
+		msg += " [" + strings.Join(fields, "; ") + "]"
+	}
+	return msg
+}
+
+// newAPIError builds an APIError from a non-2xx response, decoding whichever
+// error envelope (v3 object or v2 list) the body contains.
+func newAPIError(res *http.Response, method, path string, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: res.StatusCode,
+		Method:     method,
+		Path:       path,
+		Header:     res.Header,
+		Body:       body,
+	}
+
+	// The v3 envelope's fields are decoded one at a time so that an
+	// unexpected type in one of them, such as "errors":[], keeps the others.
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal(body, &envelope); err == nil {
+		apiErr.Title = envelopeString(envelope["title"])
+		apiErr.Type = envelopeString(envelope["type"])
+		apiErr.Detail = envelopeString(envelope["detail"])
+
+		var fields map[string]json.RawMessage
+		if json.Unmarshal(envelope["errors"], &fields) == nil && len(fields) > 0 {
+			apiErr.Errors = make(map[string]string, len(fields))
+			for field, raw := range fields {
+				var message string
+				if json.Unmarshal(raw, &message) != nil {
+					message = string(raw)
+				}
+				apiErr.Errors[field] = message
+			}
+		}
+		return apiErr
+	}
+
+	var list []apiErrorV2
+	if err := json.Unmarshal(body, &list); err == nil && len(list) > 0 {
+		apiErr.Title = list[0].Message
+		if len(list) > 1 {
+			apiErr.Errors = make(map[string]string, len(list))
+			for i, e := range list {
+				apiErr.Errors[fmt.Sprintf("%d", i)] = e.Message
+			}
+		}
+	}
+
+	return apiErr
+}
+
+// envelopeString returns raw as a string, or "" when it is not a JSON string.
+func envelopeString(raw json.RawMessage) string {
+	var s string
+	if json.Unmarshal(raw, &s) != nil {
+		return ""
+	}
+	return s
+}
+
+func hasStatus(err error, status int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == status
+}
+
+// IsNotFound reports whether err is an APIError with a 404 status.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is an APIError with a 409 status.
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+// IsValidationError reports whether err is an APIError with a 422 status.
+func IsValidationError(err error) bool {
+	return hasStatus(err, http.StatusUnprocessableEntity)
+}
+
+// IsRateLimited reports whether err is an APIError with a 429 status.
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
+
+// batchErrorIndex parses the position of the batch item an error key refers
+// to, such as "3.price", "[3].price" or "items.3.quantity".
+func batchErrorIndex(key string) (int, bool) {
+	key = strings.TrimPrefix(key, "items")
+	key = strings.TrimLeft(key, ".[")
+	end := strings.IndexFunc(key, func(r rune) bool { return r < '0' || r > '9' })
+	if end == -1 {
+		end = len(key)
+	}
+	index, err := strconv.Atoi(key[:end])
+	return index, err == nil
+}
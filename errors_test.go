@@ -0,0 +1,145 @@
+package bigcommerce
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestNewAPIError(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantTitle  string
+		wantType   string
+		wantDetail string
+		wantErrors map[string]string
+	}{
+		{
+			name:       "v3 envelope",
+			body:       `{"status":422,"title":"Input is invalid","type":"https://developer.bigcommerce.com/api-docs/getting-started/api-status-codes","detail":"Check the fields","errors":{"name":"name is required","price":{"min":0}}}`,
+			wantTitle:  "Input is invalid",
+			wantType:   "https://developer.bigcommerce.com/api-docs/getting-started/api-status-codes",
+			wantDetail: "Check the fields",
+			wantErrors: map[string]string{"name": "name is required", "price": `{"min":0}`},
+		},
+		{
+			name:       "v3 envelope with unexpected field types",
+			body:       `{"status":"422","title":"Input is invalid","type":"https://developer.bigcommerce.com/api-docs/getting-started/api-status-codes","detail":"Check the fields","errors":[]}`,
+			wantTitle:  "Input is invalid",
+			wantType:   "https://developer.bigcommerce.com/api-docs/getting-started/api-status-codes",
+			wantDetail: "Check the fields",
+		},
+		{
+			name:      "v2 list with one error",
+			body:      `[{"status":404,"message":"The requested resource was not found."}]`,
+			wantTitle: "The requested resource was not found.",
+		},
+		{
+			name:       "v2 list with several errors",
+			body:       `[{"status":400,"message":"first"},{"status":400,"message":"second"}]`,
+			wantTitle:  "first",
+			wantErrors: map[string]string{"0": "first", "1": "second"},
+		},
+		{
+			name: "not JSON",
+			body: `<html>Bad Gateway</html>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{"X-Request-Id": {"abc"}}
+			apiErr := newAPIError(&http.Response{StatusCode: http.StatusUnprocessableEntity, Header: header}, http.MethodPost, "/v3/widgets", []byte(tt.body))
+
+			if apiErr.StatusCode != http.StatusUnprocessableEntity || apiErr.Method != http.MethodPost || apiErr.Path != "/v3/widgets" {
+				t.Errorf("request fields = %d %s %s", apiErr.StatusCode, apiErr.Method, apiErr.Path)
+			}
+			if apiErr.Header.Get("X-Request-Id") != "abc" || string(apiErr.Body) != tt.body {
+				t.Errorf("response fields = %v %q", apiErr.Header, apiErr.Body)
+			}
+			if apiErr.Title != tt.wantTitle || apiErr.Type != tt.wantType || apiErr.Detail != tt.wantDetail {
+				t.Errorf("Title, Type, Detail = %q, %q, %q", apiErr.Title, apiErr.Type, apiErr.Detail)
+			}
+			if fmt.Sprint(apiErr.Errors) != fmt.Sprint(tt.wantErrors) {
+				t.Errorf("Errors = %v, want %v", apiErr.Errors, tt.wantErrors)
+			}
+		})
+	}
+}
+
+func TestAPIErrorError(t *testing.T) {
+	apiErr := &APIError{
+		StatusCode: http.StatusUnprocessableEntity,
+		Method:     http.MethodPut,
+		Path:       "/v3/catalog/products/1",
+		Title:      "Input is invalid",
+		Detail:     "Check the fields",
+		Errors:     map[string]string{"sku": "is taken", "name": "is required"},
+	}
+
+	want := "bigcommerce: PUT /v3/catalog/products/1: 422 Unprocessable Entity: Input is invalid (Check the fields) [name: is required; sku: is taken]"
+	if got := apiErr.Error(); got != want {
+		t.Errorf("Error() = %q\nwant %q", got, want)
+	}
+
+	bare := &APIError{StatusCode: http.StatusNotFound, Method: http.MethodGet, Path: "/v3/widgets/1"}
+	if got, want := bare.Error(), "bigcommerce: GET /v3/widgets/1: 404 Not Found"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestAPIErrorHelpers(t *testing.T) {
+	tests := []struct {
+		name  string
+		check func(error) bool
+		code  int
+	}{
+		{"IsNotFound", IsNotFound, http.StatusNotFound},
+		{"IsConflict", IsConflict, http.StatusConflict},
+		{"IsValidationError", IsValidationError, http.StatusUnprocessableEntity},
+		{"IsRateLimited", IsRateLimited, http.StatusTooManyRequests},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := fmt.Errorf("syncing: %w", &APIError{StatusCode: tt.code})
+			if !tt.check(err) {
+				t.Errorf("%s(wrapped %d) = false", tt.name, tt.code)
+			}
+			if tt.check(&APIError{StatusCode: http.StatusInternalServerError}) {
+				t.Errorf("%s(500) = true", tt.name)
+			}
+			if tt.check(errors.New("boom")) || tt.check(nil) {
+				t.Errorf("%s reported a non-API error", tt.name)
+			}
+		})
+	}
+}
+
+func TestBatchErrorIndex(t *testing.T) {
+	tests := []struct {
+		key       string
+		wantIndex int
+		wantOK    bool
+	}{
+		{"3.price", 3, true},
+		{"[3].price", 3, true},
+		{"items.3.quantity", 3, true},
+		{"items[12].sku", 12, true},
+		{"0", 0, true},
+		{"price", 0, false},
+		{"items", 0, false},
+		{"", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			index, ok := batchErrorIndex(tt.key)
+			if ok != tt.wantOK || (ok && index != tt.wantIndex) {
+				t.Errorf("batchErrorIndex(%q) = %d, %v, want %d, %v", tt.key, index, ok, tt.wantIndex, tt.wantOK)
+			}
+		})
+	}
+}
@@ -1,3 +1,3 @@
 module github.com/ashsmith/bigcommerce-api-go
 
-go 1.15
+go 1.17
@@ -0,0 +1,21 @@
+package bigcommerce
+
+// Bool returns a pointer to v, for optional boolean fields and filters.
+func Bool(v bool) *bool {
+	return &v
+}
+
+// Int returns a pointer to v, for optional integer fields and filters.
+func Int(v int) *int {
+	return &v
+}
+
+// Float64 returns a pointer to v, for optional decimal fields.
+func Float64(v float64) *float64 {
+	return &v
+}
+
+// String returns a pointer to v, for optional string fields.
+func String(v string) *string {
+	return &v
+}
@@ -0,0 +1,555 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Location types, as used by InventoryLocation.TypeID.
+const (
+	LocationTypePhysical = "PHYSICAL"
+	LocationTypeVirtual  = "VIRTUAL"
+)
+
+// InventoryService groups the inventory services.
+type InventoryService struct {
+	Stock     InventoryStockService
+	Locations InventoryLocationService
+}
+
+// InventoryStockService adjusts and reports the stock of items at locations.
+type InventoryStockService interface {
+	AdjustAbsolute(InventoryAdjustment) (string, error)
+	AdjustAbsoluteWithContext(context.Context, InventoryAdjustment) (string, error)
+	AdjustRelative(InventoryAdjustment) (string, error)
+	AdjustRelativeWithContext(context.Context, InventoryAdjustment) (string, error)
+	ListItems(...ListInventoryItemsOptions) (ListInventoryItemResponse, error)
+	ListItemsWithContext(context.Context, ...ListInventoryItemsOptions) (ListInventoryItemResponse, error)
+	IterateItems(context.Context, IteratorOptions, ...ListInventoryItemsOptions) *Iterator
+	ListLocationItems(int, ...ListInventoryItemsOptions) (ListLocationItemResponse, error)
+	ListLocationItemsWithContext(context.Context, int, ...ListInventoryItemsOptions) (ListLocationItemResponse, error)
+	UpdateLocationItems(int, []LocationItemSettings) (string, error)
+	UpdateLocationItemsWithContext(context.Context, int, []LocationItemSettings) (string, error)
+}
+
+type InventoryLocationService interface {
+	List(...ListInventoryLocationsOptions) (ListInventoryLocationResponse, error)
+	ListWithContext(context.Context, ...ListInventoryLocationsOptions) (ListInventoryLocationResponse, error)
+	Create([]InventoryLocation) (string, error)
+	CreateWithContext(context.Context, []InventoryLocation) (string, error)
+	Update([]InventoryLocation) (string, error)
+	UpdateWithContext(context.Context, []InventoryLocation) (string, error)
+	Delete([]int) error
+	DeleteWithContext(context.Context, []int) error
+	Iterate(context.Context, IteratorOptions, ...ListInventoryLocationsOptions) *Iterator
+}
+
+type ListInventoryItemResponse struct {
+	Data []InventoryItem `json:"data"`
+	Meta MetaResult      `json:"meta"`
+}
+
+type ListLocationItemResponse struct {
+	Data []LocationItem `json:"data"`
+	Meta MetaResult     `json:"meta"`
+}
+
+type ListInventoryLocationResponse struct {
+	Data []InventoryLocation `json:"data"`
+	Meta MetaResult          `json:"meta"`
+}
+
+// InventoryAdjustment is a batch of stock changes recorded with a reason.
+type InventoryAdjustment struct {
+	Reason string                    `json:"reason,omitempty"`
+	Items  []InventoryAdjustmentItem `json:"items"`
+}
+
+// InventoryAdjustmentItem is the stock of a product or variant at a location.
+// The item is identified by one of VariantID, ProductID (for products
+// without variants) or SKU.
+type InventoryAdjustmentItem struct {
+	LocationID int    `json:"location_id"`
+	VariantID  int    `json:"variant_id,omitempty"`
+	ProductID  int    `json:"product_id,omitempty"`
+	SKU        string `json:"sku,omitempty"`
+	Quantity   int    `json:"quantity"`
+}
+
+// InventoryItem is the stock of a product or variant at every location.
+type InventoryItem struct {
+	Identity  InventoryIdentity       `json:"identity"`
+	Locations []InventoryItemLocation `json:"locations"`
+}
+
+// InventoryIdentity identifies the product or variant of an inventory item.
+type InventoryIdentity struct {
+	SKU       string `json:"sku"`
+	SKUID     int    `json:"sku_id"`
+	VariantID int    `json:"variant_id"`
+	ProductID int    `json:"product_id"`
+}
+
+// InventoryItemLocation is the stock of an inventory item at a location.
+type InventoryItemLocation struct {
+	LocationID           int                   `json:"location_id"`
+	LocationCode         string                `json:"location_code"`
+	LocationName         string                `json:"location_name"`
+	AvailableToSell      int                   `json:"available_to_sell"`
+	TotalInventoryOnhand int                   `json:"total_inventory_onhand"`
+	Settings             InventoryItemSettings `json:"settings"`
+}
+
+// InventoryItemSettings are the stock settings of an item at a location.
+type InventoryItemSettings struct {
+	SafetyStock      int    `json:"safety_stock"`
+	IsInStock        bool   `json:"is_in_stock"`
+	WarningLevel     int    `json:"warning_level"`
+	BinPickingNumber string `json:"bin_picking_number"`
+}
+
+// LocationItem is the stock of an item at a single location.
+type LocationItem struct {
+	Identity             InventoryIdentity     `json:"identity"`
+	AvailableToSell      int                   `json:"available_to_sell"`
+	TotalInventoryOnhand int                   `json:"total_inventory_onhand"`
+	Settings             InventoryItemSettings `json:"settings"`
+}
+
+// LocationItemSettings updates the settings of an item at a location.
+type LocationItemSettings struct {
+	Identity         LocationItemIdentity `json:"identity"`
+	SafetyStock      *int                 `json:"safety_stock,omitempty"`
+	IsInStock        *bool                `json:"is_in_stock,omitempty"`
+	WarningLevel     *int                 `json:"warning_level,omitempty"`
+	BinPickingNumber *string              `json:"bin_picking_number,omitempty"`
+}
+
+// LocationItemIdentity selects the item whose settings are updated, by one
+// of VariantID, ProductID (for products without variants) or SKU.
+type LocationItemIdentity struct {
+	VariantID int    `json:"variant_id,omitempty"`
+	ProductID int    `json:"product_id,omitempty"`
+	SKU       string `json:"sku,omitempty"`
+}
+
+// InventoryLocation is a warehouse, store or other place stock is held.
+type InventoryLocation struct {
+	ID                      int                       `json:"id,omitempty"`
+	Code                    string                    `json:"code,omitempty"`
+	Label                   string                    `json:"label,omitempty"`
+	Description             string                    `json:"description,omitempty"`
+	TypeID                  string                    `json:"type_id,omitempty"`
+	Enabled                 *bool                     `json:"enabled,omitempty"`
+	StorefrontVisibility    *bool                     `json:"storefront_visibility,omitempty"`
+	ManagedByExternalSource *bool                     `json:"managed_by_external_source,omitempty"`
+	TimeZone                string                    `json:"time_zone,omitempty"`
+	Address                 *InventoryLocationAddress `json:"address,omitempty"`
+	CreatedAt               string                    `json:"created_at,omitempty"`
+	UpdatedAt               string                    `json:"updated_at,omitempty"`
+}
+
+// InventoryLocationAddress is the address of a location.
+type InventoryLocationAddress struct {
+	Address1       string                   `json:"address1,omitempty"`
+	Address2       string                   `json:"address2,omitempty"`
+	City           string                   `json:"city,omitempty"`
+	State          string                   `json:"state,omitempty"`
+	Zip            string                   `json:"zip,omitempty"`
+	CountryCode    string                   `json:"country_code,omitempty"`
+	Email          string                   `json:"email,omitempty"`
+	Phone          string                   `json:"phone,omitempty"`
+	GeoCoordinates *InventoryGeoCoordinates `json:"geo_coordinates,omitempty"`
+}
+
+// InventoryGeoCoordinates is the position of a location.
+type InventoryGeoCoordinates struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// ListInventoryItemsOptions filters the items returned by ListItems and ListLocationItems.
+type ListInventoryItemsOptions struct {
+	ListOptions
+	VariantIDIn  []int    `url:"variant_id:in,omitempty"`
+	ProductIDIn  []int    `url:"product_id:in,omitempty"`
+	SKUIn        []string `url:"sku:in,omitempty"`
+	LocationIDIn []int    `url:"location_id:in,omitempty"`
+}
+
+// ListInventoryLocationsOptions filters the locations returned by List.
+type ListInventoryLocationsOptions struct {
+	ListOptions
+	LocationIDIn   []int    `url:"location_id:in,omitempty"`
+	LocationCodeIn []string `url:"location_code:in,omitempty"`
+	TypeIDIn       []string `url:"type_id:in,omitempty"`
+	IsActive       *bool    `url:"is_active,omitempty"`
+}
+
+// InventoryAdjustmentError is returned when the API rejects an adjustment.
+// None of the items are applied; Failures lists the items the API reported
+// errors against.
+type InventoryAdjustmentError struct {
+	Failures []InventoryItemFailure
+	Err      error
+}
+
+// InventoryItemFailure is an item of an adjustment that the API rejected.
+type InventoryItemFailure struct {
+	// Index is the position of the item in InventoryAdjustment.Items.
+	Index   int
+	Item    InventoryAdjustmentItem
+	Message string
+}
+
+func (e *InventoryAdjustmentError) Error() string {
+	if len(e.Failures) == 0 {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("bigcommerce: %d inventory items rejected: %v", len(e.Failures), e.Err)
+}
+
+func (e *InventoryAdjustmentError) Unwrap() error {
+	return e.Err
+}
+
+// newInventoryAdjustmentError wraps an *APIError rejecting an adjustment,
+// attributing its per-field errors ("items.3.quantity") to the items.
+func newInventoryAdjustmentError(adjustment InventoryAdjustment, err error) error {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnprocessableEntity {
+		return err
+	}
+
+	messages := map[int][]string{}
+	for key, message := range apiErr.Errors {
+		if index, ok := batchErrorIndex(key); ok && index < len(adjustment.Items) {
+			messages[index] = append(messages[index], key+": "+message)
+		}
+	}
+
+	adjustmentErr := &InventoryAdjustmentError{Err: err}
+	for index, item := range adjustment.Items {
+		if itemMessages, ok := messages[index]; ok {
+			sort.Strings(itemMessages)
+			adjustmentErr.Failures = append(adjustmentErr.Failures, InventoryItemFailure{
+				Index:   index,
+				Item:    item,
+				Message: strings.Join(itemMessages, "; "),
+			})
+		}
+	}
+	return adjustmentErr
+}
+
+type inventoryTransaction struct {
+	TransactionID string `json:"transaction_id"`
+}
+
+type locationItemsRequest struct {
+	Settings []LocationItemSettings `json:"settings"`
+}
+
+type InventoryStockServiceOp struct {
+	client *Client
+}
+
+type InventoryLocationServiceOp struct {
+	client *Client
+}
+
+// AdjustAbsolute will set the stock level of each item at its location, returning
+// the ID of the inventory transaction. Items the API rejects are reported in an
+// *InventoryAdjustmentError.
+func (s *InventoryStockServiceOp) AdjustAbsolute(adjustment InventoryAdjustment) (string, error) {
+	return s.AdjustAbsoluteWithContext(context.Background(), adjustment)
+}
+
+// AdjustAbsoluteWithContext is the context-aware variant of AdjustAbsolute.
+func (s *InventoryStockServiceOp) AdjustAbsoluteWithContext(ctx context.Context, adjustment InventoryAdjustment) (string, error) {
+	transaction := inventoryTransaction{}
+
+	jsonBody, err := json.Marshal(adjustment)
+	if err != nil {
+		return "", err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, "/v3/inventory/adjustments/absolute", reqBody)
+	if reqErr != nil {
+		return "", newInventoryAdjustmentError(adjustment, reqErr)
+	}
+
+	jsonErr := json.Unmarshal(body, &transaction)
+	if jsonErr != nil {
+		return "", jsonErr
+	}
+	return transaction.TransactionID, nil
+}
+
+// AdjustRelative will add each item's quantity, which may be negative, to its stock
+// level at its location, returning the ID of the inventory transaction. Items
+// the API rejects are reported in an *InventoryAdjustmentError.
+func (s *InventoryStockServiceOp) AdjustRelative(adjustment InventoryAdjustment) (string, error) {
+	return s.AdjustRelativeWithContext(context.Background(), adjustment)
+}
+
+// AdjustRelativeWithContext is the context-aware variant of AdjustRelative.
+func (s *InventoryStockServiceOp) AdjustRelativeWithContext(ctx context.Context, adjustment InventoryAdjustment) (string, error) {
+	transaction := inventoryTransaction{}
+
+	jsonBody, err := json.Marshal(adjustment)
+	if err != nil {
+		return "", err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, "/v3/inventory/adjustments/relative", reqBody)
+	if reqErr != nil {
+		return "", newInventoryAdjustmentError(adjustment, reqErr)
+	}
+
+	jsonErr := json.Unmarshal(body, &transaction)
+	if jsonErr != nil {
+		return "", jsonErr
+	}
+	return transaction.TransactionID, nil
+}
+
+// ListItems will return a page of inventory items, with their stock at each location, matching the options.
+func (s *InventoryStockServiceOp) ListItems(options ...ListInventoryItemsOptions) (ListInventoryItemResponse, error) {
+	return s.ListItemsWithContext(context.Background(), options...)
+}
+
+// ListItemsWithContext is the context-aware variant of ListItems.
+func (s *InventoryStockServiceOp) ListItemsWithContext(ctx context.Context, options ...ListInventoryItemsOptions) (ListInventoryItemResponse, error) {
+	listResult := ListInventoryItemResponse{}
+
+	var listOptions ListInventoryItemsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/inventory/items", listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// IterateItems will walk every inventory item matching the options, fetching further pages as needed.
+func (s *InventoryStockServiceOp) IterateItems(ctx context.Context, iteratorOptions IteratorOptions, options ...ListInventoryItemsOptions) *Iterator {
+	var listOptions ListInventoryItemsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListItemsWithContext(ctx, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
+
+// ListLocationItems will return a page of the inventory items at a location matching the options.
+func (s *InventoryStockServiceOp) ListLocationItems(locationID int, options ...ListInventoryItemsOptions) (ListLocationItemResponse, error) {
+	return s.ListLocationItemsWithContext(context.Background(), locationID, options...)
+}
+
+// ListLocationItemsWithContext is the context-aware variant of ListLocationItems.
+func (s *InventoryStockServiceOp) ListLocationItemsWithContext(ctx context.Context, locationID int, options ...ListInventoryItemsOptions) (ListLocationItemResponse, error) {
+	listResult := ListLocationItemResponse{}
+
+	var listOptions ListInventoryItemsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/inventory/locations/%d/items", locationID), listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// UpdateLocationItems will update the settings, such as the safety stock, of items at a location.
+func (s *InventoryStockServiceOp) UpdateLocationItems(locationID int, items []LocationItemSettings) (string, error) {
+	return s.UpdateLocationItemsWithContext(context.Background(), locationID, items)
+}
+
+// UpdateLocationItemsWithContext is the context-aware variant of UpdateLocationItems.
+func (s *InventoryStockServiceOp) UpdateLocationItemsWithContext(ctx context.Context, locationID int, items []LocationItemSettings) (string, error) {
+	transaction := inventoryTransaction{}
+	jsonBody, err := json.Marshal(locationItemsRequest{Settings: items})
+	if err != nil {
+		return "", err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/inventory/locations/%d/items", locationID), reqBody)
+	if reqErr != nil {
+		return "", reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &transaction)
+	if jsonErr != nil {
+		return "", jsonErr
+	}
+	return transaction.TransactionID, nil
+}
+
+// List will return a page of locations matching the options.
+func (s *InventoryLocationServiceOp) List(options ...ListInventoryLocationsOptions) (ListInventoryLocationResponse, error) {
+	return s.ListWithContext(context.Background(), options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *InventoryLocationServiceOp) ListWithContext(ctx context.Context, options ...ListInventoryLocationsOptions) (ListInventoryLocationResponse, error) {
+	listResult := ListInventoryLocationResponse{}
+
+	var listOptions ListInventoryLocationsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/inventory/locations", listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// Create will create the provided locations, returning the ID of the inventory transaction.
+// The fields required on a location are: Code, Label, TypeID and Address.
+func (s *InventoryLocationServiceOp) Create(locations []InventoryLocation) (string, error) {
+	return s.CreateWithContext(context.Background(), locations)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *InventoryLocationServiceOp) CreateWithContext(ctx context.Context, locations []InventoryLocation) (string, error) {
+	transaction := inventoryTransaction{}
+	jsonBody, err := json.Marshal(locations)
+	if err != nil {
+		return "", err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, "/v3/inventory/locations", reqBody)
+	if reqErr != nil {
+		return "", reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &transaction)
+	if jsonErr != nil {
+		return "", jsonErr
+	}
+	return transaction.TransactionID, nil
+}
+
+// Update will update the provided locations, returning the ID of the inventory transaction.
+// Every location must have its ID set.
+func (s *InventoryLocationServiceOp) Update(locations []InventoryLocation) (string, error) {
+	return s.UpdateWithContext(context.Background(), locations)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *InventoryLocationServiceOp) UpdateWithContext(ctx context.Context, locations []InventoryLocation) (string, error) {
+	transaction := inventoryTransaction{}
+	jsonBody, err := json.Marshal(locations)
+	if err != nil {
+		return "", err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, "/v3/inventory/locations", reqBody)
+	if reqErr != nil {
+		return "", reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &transaction)
+	if jsonErr != nil {
+		return "", jsonErr
+	}
+	return transaction.TransactionID, nil
+}
+
+// Delete will delete every location with one of the provided IDs.
+// Locations that hold stock cannot be deleted.
+func (s *InventoryLocationServiceOp) Delete(ids []int) error {
+	return s.DeleteWithContext(context.Background(), ids)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *InventoryLocationServiceOp) DeleteWithContext(ctx context.Context, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	path, err := addQuery("/v3/inventory/locations", ListInventoryLocationsOptions{LocationIDIn: ids})
+	if err != nil {
+		return err
+	}
+
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, path, nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// Iterate will walk every location matching the options, fetching further pages as needed.
+func (s *InventoryLocationServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, options ...ListInventoryLocationsOptions) *Iterator {
+	var listOptions ListInventoryLocationsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListWithContext(ctx, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
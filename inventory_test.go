@@ -0,0 +1,64 @@
+package bigcommerce
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestInventoryAdjustAbsoluteReportsRejectedItems(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"status":422,"title":"Invalid items","errors":{"items.1.quantity":"must be at least 0","items.1.location_id":"unknown location"}}`))
+	})
+
+	adjustment := InventoryAdjustment{Reason: "recount", Items: []InventoryAdjustmentItem{
+		{LocationID: 1, SKU: "A", Quantity: 5},
+		{LocationID: 9, SKU: "B", Quantity: -1},
+	}}
+	_, err := client.Inventory.Stock.AdjustAbsolute(adjustment)
+
+	var adjustmentErr *InventoryAdjustmentError
+	if !errors.As(err, &adjustmentErr) {
+		t.Fatalf("err = %v, want an *InventoryAdjustmentError", err)
+	}
+	if len(adjustmentErr.Failures) != 1 {
+		t.Fatalf("failures = %+v, want only the second item", adjustmentErr.Failures)
+	}
+	failure := adjustmentErr.Failures[0]
+	if failure.Index != 1 || failure.Item.SKU != "B" || failure.Message != "items.1.location_id: unknown location; items.1.quantity: must be at least 0" {
+		t.Errorf("failure = %+v", failure)
+	}
+	if !IsValidationError(err) {
+		t.Errorf("err = %v, want it to wrap the 422 APIError", err)
+	}
+}
+
+func TestInventoryAdjustAbsoluteKeepsOtherErrors(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.Inventory.Stock.AdjustAbsolute(InventoryAdjustment{Items: []InventoryAdjustmentItem{{LocationID: 1, SKU: "A"}}})
+
+	var adjustmentErr *InventoryAdjustmentError
+	if errors.As(err, &adjustmentErr) || !IsNotFound(err) {
+		t.Errorf("err = %v, want the plain 404 APIError", err)
+	}
+}
+
+func TestInventoryUpdateLocationItemsSendsOneIdentifier(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if want := `{"settings":[{"identity":{"sku":"A"},"safety_stock":0}]}`; string(body) != want {
+			t.Errorf("body = %s\nwant %s", body, want)
+		}
+		w.Write([]byte(`{"transaction_id":"t1"}`))
+	})
+
+	items := []LocationItemSettings{{Identity: LocationItemIdentity{SKU: "A"}, SafetyStock: Int(0)}}
+	if _, err := client.Inventory.Stock.UpdateLocationItems(1, items); err != nil {
+		t.Fatalf("UpdateLocationItems: %v", err)
+	}
+}
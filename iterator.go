@@ -0,0 +1,214 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"reflect"
+)
+
+// DefaultPageSize is the number of items requested per page by iterators
+// when IteratorOptions.PageSize isn't set.
+const DefaultPageSize = 50
+
+// Paginator is implemented by the pagination metadata of list responses.
+type Paginator interface {
+	HasNextPage() bool
+}
+
+// HasNextPage reports whether pages follow the current one.
+func (p PaginationResult) HasNextPage() bool {
+	if p.Totalpages > 0 {
+		return p.CurrentPage < p.Totalpages
+	}
+	return p.Links.Next != ""
+}
+
+// HasNextPage reports whether items follow the current page.
+func (p WebhookPaginationResult) HasNextPage() bool {
+	return p.Limit > 0 && p.Offset+p.Limit < p.TotalItems
+}
+
+// PageFunc fetches a single page of a list endpoint. page is 1-based and
+// limit is the page size. items must be a slice.
+type PageFunc func(ctx context.Context, page, limit int) (items interface{}, pagination Paginator, err error)
+
+// IteratorOptions configures an Iterator.
+type IteratorOptions struct {
+	// PageSize is the number of items requested per page. Defaults to DefaultPageSize.
+	PageSize int
+	// Prefetch is the number of pages fetched ahead in the background while
+	// the current page is consumed. Zero fetches pages on demand.
+	Prefetch int
+}
+
+type pageResult struct {
+	items reflect.Value
+	more  bool
+	err   error
+}
+
+// Iterator walks every item of a paginated list endpoint.
+//
+//	it := client.Webhooks.Iterate(ctx, bc.IteratorOptions{})
+//	defer it.Close()
+//	for it.Next() {
+//		webhook := it.Value().(bc.Webhook)
+//	}
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+type Iterator struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	fetch   PageFunc
+	limit   int
+	page    int
+	items   reflect.Value
+	index   int
+	more    bool
+	err     error
+	pages   chan pageResult
+	started bool
+}
+
+// NewIterator returns an Iterator that fetches pages with fetch.
+func NewIterator(ctx context.Context, fetch PageFunc, options IteratorOptions) *Iterator {
+	limit := options.PageSize
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	it := &Iterator{
+		ctx:    ctx,
+		cancel: cancel,
+		fetch:  fetch,
+		limit:  limit,
+		more:   true,
+	}
+
+	if options.Prefetch > 0 {
+		it.pages = make(chan pageResult, options.Prefetch)
+		go it.prefetch()
+	}
+
+	return it
+}
+
+// Next advances to the next item, fetching the next page when needed.
+// It returns false when the items are exhausted or an error occurred.
+func (it *Iterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	if it.started && it.index+1 < it.items.Len() {
+		it.index++
+		return true
+	}
+
+	for it.more {
+		result := it.nextPage()
+		if result.err != nil {
+			it.err = result.err
+			it.cancel()
+			return false
+		}
+
+		it.started = true
+		it.items = result.items
+		it.more = result.more
+		it.index = 0
+		if it.items.Len() > 0 {
+			return true
+		}
+	}
+
+	it.cancel()
+	return false
+}
+
+// Value returns the current item. Assert it to the endpoint's item type.
+func (it *Iterator) Value() interface{} {
+	if !it.started || it.index >= it.items.Len() {
+		return nil
+	}
+	return it.items.Index(it.index).Interface()
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// Close stops any background prefetching. It is safe to call more than once.
+func (it *Iterator) Close() {
+	it.cancel()
+}
+
+func (it *Iterator) nextPage() pageResult {
+	if it.pages == nil {
+		it.page++
+		return it.fetchPage(it.page)
+	}
+
+	select {
+	case result, ok := <-it.pages:
+		if !ok {
+			return pageResult{err: it.ctx.Err()}
+		}
+		return result
+	case <-it.ctx.Done():
+		return pageResult{err: it.ctx.Err()}
+	}
+}
+
+func (it *Iterator) prefetch() {
+	defer close(it.pages)
+
+	for page := 1; ; page++ {
+		result := it.fetchPage(page)
+		select {
+		case it.pages <- result:
+		case <-it.ctx.Done():
+			return
+		}
+
+		if result.err != nil || !result.more {
+			return
+		}
+	}
+}
+
+func (it *Iterator) fetchPage(page int) pageResult {
+	items, pagination, err := it.fetch(it.ctx, page, it.limit)
+	if err != nil {
+		return pageResult{err: err}
+	}
+
+	value := reflect.ValueOf(items)
+	if value.Kind() != reflect.Slice {
+		return pageResult{err: errors.New("bigcommerce: PageFunc must return a slice of items")}
+	}
+
+	more := pagination != nil && pagination.HasNextPage() && value.Len() > 0
+	return pageResult{items: value, more: more}
+}
+
+// AllPages drains it, appending every item to the slice dst points to.
+func AllPages(it *Iterator, dst interface{}) error {
+	defer it.Close()
+
+	out := reflect.ValueOf(dst)
+	if out.Kind() != reflect.Ptr || out.Elem().Kind() != reflect.Slice {
+		return errors.New("bigcommerce: AllPages requires a pointer to a slice")
+	}
+
+	slice := out.Elem()
+	for it.Next() {
+		slice = reflect.Append(slice, reflect.ValueOf(it.Value()))
+	}
+	out.Elem().Set(slice)
+
+	return it.Err()
+}
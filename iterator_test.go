@@ -0,0 +1,139 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+// pagedInts returns a PageFunc serving the integers 1 to total in pages,
+// counting the pages it fetches in calls.
+func pagedInts(total int, calls *int) PageFunc {
+	return func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		*calls++
+		items := []int{}
+		for i := (page-1)*limit + 1; i <= total && i <= page*limit; i++ {
+			items = append(items, i)
+		}
+		totalPages := int64((total + limit - 1) / limit)
+		return items, PaginationResult{CurrentPage: int64(page), Totalpages: totalPages}, nil
+	}
+}
+
+func TestIteratorWalksEveryPage(t *testing.T) {
+	for _, prefetch := range []int{0, 2} {
+		t.Run(fmt.Sprintf("prefetch %d", prefetch), func(t *testing.T) {
+			calls := 0
+			it := NewIterator(context.Background(), pagedInts(7, &calls), IteratorOptions{PageSize: 3, Prefetch: prefetch})
+			defer it.Close()
+
+			var got []int
+			for it.Next() {
+				got = append(got, it.Value().(int))
+			}
+			if err := it.Err(); err != nil {
+				t.Fatalf("Err() = %v", err)
+			}
+			if fmt.Sprint(got) != "[1 2 3 4 5 6 7]" {
+				t.Errorf("items = %v", got)
+			}
+			if calls != 3 {
+				t.Errorf("fetched %d pages, want 3", calls)
+			}
+		})
+	}
+}
+
+func TestIteratorStopsOnError(t *testing.T) {
+	fetchErr := errors.New("boom")
+	it := NewIterator(context.Background(), func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		if page == 2 {
+			return nil, nil, fetchErr
+		}
+		return []int{1, 2}, PaginationResult{CurrentPage: 1, Totalpages: 3}, nil
+	}, IteratorOptions{PageSize: 2})
+
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if count != 2 || it.Err() != fetchErr {
+		t.Errorf("got %d items and Err() = %v, want 2 items and %v", count, it.Err(), fetchErr)
+	}
+	if it.Next() {
+		t.Error("Next() = true after an error")
+	}
+}
+
+func TestIteratorRejectsNonSlicePages(t *testing.T) {
+	it := NewIterator(context.Background(), func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		return 1, nil, nil
+	}, IteratorOptions{})
+
+	if it.Next() || it.Err() == nil {
+		t.Errorf("Next() succeeded on a non-slice page, Err() = %v", it.Err())
+	}
+}
+
+func TestAllPages(t *testing.T) {
+	calls := 0
+	var got []int
+	if err := AllPages(NewIterator(context.Background(), pagedInts(5, &calls), IteratorOptions{PageSize: 2}), &got); err != nil {
+		t.Fatalf("AllPages: %v", err)
+	}
+	if fmt.Sprint(got) != "[1 2 3 4 5]" {
+		t.Errorf("items = %v", got)
+	}
+
+	var notSlice []int
+	if err := AllPages(NewIterator(context.Background(), pagedInts(1, &calls), IteratorOptions{}), notSlice); err == nil {
+		t.Error("AllPages accepted a slice rather than a pointer to one")
+	}
+}
+
+func TestPaginatorHasNextPage(t *testing.T) {
+	tests := []struct {
+		name       string
+		pagination Paginator
+		want       bool
+	}{
+		{"more pages", PaginationResult{CurrentPage: 1, Totalpages: 2}, true},
+		{"last page", PaginationResult{CurrentPage: 2, Totalpages: 2}, false},
+		{"next link", PaginationResult{Links: Links{Next: "?page=2"}}, true},
+		{"no next link", PaginationResult{}, false},
+		{"more webhooks", WebhookPaginationResult{Offset: 0, Limit: 50, TotalItems: 51}, true},
+		{"last webhooks", WebhookPaginationResult{Offset: 50, Limit: 50, TotalItems: 51}, false},
+		{"no webhook limit", WebhookPaginationResult{TotalItems: 51}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.pagination.HasNextPage(); got != tt.want {
+				t.Errorf("HasNextPage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebhooksIterateUsesOffsetPagination(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		offset := (page - 1) * 2
+		ids := fmt.Sprintf(`{"id":%d},{"id":%d}`, offset+1, offset+2)
+		if page == 2 {
+			ids = fmt.Sprintf(`{"id":%d}`, offset+1)
+		}
+		fmt.Fprintf(w, `{"data":[%s],"meta":{"pagination":{"offset":%d,"limit":2,"total_items":3}}}`, ids, offset)
+	})
+
+	var webhooks []Webhook
+	if err := AllPages(client.Webhooks.Iterate(context.Background(), IteratorOptions{PageSize: 2}), &webhooks); err != nil {
+		t.Fatalf("AllPages: %v", err)
+	}
+	if len(webhooks) != 3 || webhooks[2].ID != 3 {
+		t.Errorf("webhooks = %+v, want IDs 1 to 3", webhooks)
+	}
+}
@@ -0,0 +1,21 @@
+package bigcommerce
+
+import "time"
+
+// defaultJobPollInterval is how often asynchronous jobs are checked by default.
+const defaultJobPollInterval = 2 * time.Second
+
+// JobWaitOptions controls how the WaitForJob helpers poll an asynchronous
+// job, such as a theme upload or a redirect import.
+type JobWaitOptions struct {
+	// PollInterval is the time between checks of the job. Defaults to 2s.
+	PollInterval time.Duration
+}
+
+// jobPollInterval returns the poll interval of the first options, if any.
+func jobPollInterval(options []JobWaitOptions) time.Duration {
+	if len(options) > 0 && options[0].PollInterval > 0 {
+		return options[0].PollInterval
+	}
+	return defaultJobPollInterval
+}
@@ -0,0 +1,41 @@
+package bigcommerce
+
+// Values accepted by Metafield.PermissionSet.
+const (
+	MetafieldPermissionAppOnly          = "app_only"
+	MetafieldPermissionRead             = "read"
+	MetafieldPermissionWrite            = "write"
+	MetafieldPermissionReadAndSFAccess  = "read_and_sf_access"
+	MetafieldPermissionWriteAndSFAccess = "write_and_sf_access"
+)
+
+type GetMetafieldResponse struct {
+	Data Metafield `json:"data"`
+}
+
+type ListMetafieldResponse struct {
+	Data []Metafield `json:"data"`
+	Meta MetaResult  `json:"meta"`
+}
+
+// Metafield structure.
+// Metafields attach private key/value data to a resource, grouped by namespace.
+type Metafield struct {
+	ID            int    `json:"id,omitempty"`
+	Key           string `json:"key,omitempty"`
+	Value         string `json:"value,omitempty"`
+	Namespace     string `json:"namespace,omitempty"`
+	PermissionSet string `json:"permission_set,omitempty"`
+	Description   string `json:"description,omitempty"`
+	ResourceType  string `json:"resource_type,omitempty"`
+	ResourceID    int    `json:"resource_id,omitempty"`
+	DateCreated   string `json:"date_created,omitempty"`
+	DateModified  string `json:"date_modified,omitempty"`
+}
+
+// ListMetafieldsOptions filters the metafields returned by ListMetafields.
+type ListMetafieldsOptions struct {
+	ListOptions
+	Key       string `url:"key,omitempty"`
+	Namespace string `url:"namespace,omitempty"`
+}
@@ -0,0 +1,148 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// MultipartFile is a file sent in a multipart/form-data request.
+type MultipartFile struct {
+	Field    string
+	Filename string
+	Reader   io.Reader
+}
+
+// ImageUpload is the source of an image attached to a catalog resource.
+// Set exactly one of ImageURL, FilePath or Reader.
+type ImageUpload struct {
+	// ImageURL is a publicly reachable URL BigCommerce downloads the image from.
+	ImageURL string
+	// FilePath is a local file that is uploaded.
+	FilePath string
+	// Reader supplies the image content to upload.
+	Reader io.Reader
+	// Filename names the uploaded file. It defaults to the base name of FilePath.
+	Filename string
+}
+
+// ImageURLResponse is returned by the endpoints that set the single image of a resource.
+type ImageURLResponse struct {
+	Data struct {
+		ImageURL string `json:"image_url"`
+	} `json:"data"`
+}
+
+// ImageFromURL returns an ImageUpload that BigCommerce fetches from url.
+func ImageFromURL(url string) ImageUpload {
+	return ImageUpload{ImageURL: url}
+}
+
+// ImageFromFile returns an ImageUpload that uploads the local file at path.
+func ImageFromFile(path string) ImageUpload {
+	return ImageUpload{FilePath: path}
+}
+
+// ImageFromReader returns an ImageUpload that uploads the content of r as filename.
+func ImageFromReader(filename string, r io.Reader) ImageUpload {
+	return ImageUpload{Filename: filename, Reader: r}
+}
+
+func (u ImageUpload) isFile() bool {
+	return u.FilePath != "" || u.Reader != nil
+}
+
+// validate returns an error unless exactly one of ImageURL, FilePath or
+// Reader is set, so every service picks the image source the same way.
+func (u ImageUpload) validate() error {
+	sources := 0
+	for _, set := range []bool{u.ImageURL != "", u.FilePath != "", u.Reader != nil} {
+		if set {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return errors.New("bigcommerce: an ImageUpload needs exactly one of ImageURL, FilePath or Reader")
+	}
+	return nil
+}
+
+// DoMultipartRequest will send fields and files as a multipart/form-data request and return the response.
+func (c *Client) DoMultipartRequest(method, path string, fields map[string]string, files ...MultipartFile) ([]byte, error) {
+	return c.DoMultipartRequestWithContext(context.Background(), method, path, fields, files...)
+}
+
+// DoMultipartRequestWithContext is the context-aware variant of DoMultipartRequest.
+func (c *Client) DoMultipartRequestWithContext(ctx context.Context, method, path string, fields map[string]string, files ...MultipartFile) ([]byte, error) {
+	reqBody, contentType, err := newMultipartBody(fields, files...)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doRequest(ctx, method, path, http.Header{"Content-Type": {contentType}}, reqBody)
+}
+
+// uploadImage sends the file of upload in the image_file field of a
+// multipart request, alongside fields. upload must be a validated file.
+func (c *Client) uploadImage(ctx context.Context, method, path string, upload ImageUpload, fields map[string]string) ([]byte, error) {
+	reader := upload.Reader
+	filename := upload.Filename
+	if upload.FilePath != "" {
+		file, err := os.Open(upload.FilePath)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		reader = file
+		if filename == "" {
+			filename = filepath.Base(upload.FilePath)
+		}
+	}
+
+	if filename == "" {
+		filename = "image"
+	}
+
+	return c.DoMultipartRequestWithContext(ctx, method, path, fields, MultipartFile{Field: "image_file", Filename: filename, Reader: reader})
+}
+
+// newMultipartBody encodes fields and files as a multipart/form-data body,
+// returning the body and its content type.
+func newMultipartBody(fields map[string]string, files ...MultipartFile) (*bytes.Buffer, string, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := writer.WriteField(key, fields[key]); err != nil {
+			return nil, "", err
+		}
+	}
+
+	for _, file := range files {
+		part, err := writer.CreateFormFile(file.Field, file.Filename)
+		if err != nil {
+			return nil, "", err
+		}
+		if _, err := io.Copy(part, file.Reader); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", err
+	}
+
+	return body, writer.FormDataContentType(), nil
+}
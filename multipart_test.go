@@ -0,0 +1,64 @@
+package bigcommerce
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestImageUploadValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		upload  ImageUpload
+		wantErr bool
+	}{
+		{"url", ImageFromURL("https://example.com/a.jpg"), false},
+		{"file", ImageFromFile("a.jpg"), false},
+		{"reader", ImageFromReader("a.jpg", strings.NewReader("jpeg")), false},
+		{"empty", ImageUpload{}, true},
+		{"filename only", ImageUpload{Filename: "a.jpg"}, true},
+		{"url and file", ImageUpload{ImageURL: "https://example.com/a.jpg", FilePath: "a.jpg"}, true},
+		{"file and reader", ImageUpload{FilePath: "a.jpg", Reader: strings.NewReader("jpeg")}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.upload.validate(); (err != nil) != tt.wantErr {
+				t.Errorf("validate() = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUploadImageRejectsInvalidUploads(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+	})
+
+	uploads := map[string]func(ImageUpload) error{
+		"product": func(upload ImageUpload) error {
+			_, err := client.Catalog.ProductImages.Create(1, ProductImage{}, upload)
+			return err
+		},
+		"variant": func(upload ImageUpload) error {
+			_, err := client.Catalog.Variants.UploadImage(1, 2, upload)
+			return err
+		},
+		"brand": func(upload ImageUpload) error {
+			_, err := client.Catalog.Brands.UploadImage(1, upload)
+			return err
+		},
+		"category": func(upload ImageUpload) error {
+			_, err := client.Catalog.Categories.UploadImage(1, upload)
+			return err
+		},
+	}
+
+	for name, upload := range uploads {
+		for _, invalid := range []ImageUpload{{}, {ImageURL: "https://example.com/a.jpg", Reader: strings.NewReader("jpeg")}} {
+			if err := upload(invalid); err == nil {
+				t.Errorf("%s accepted %+v", name, invalid)
+			}
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package bigcommerce
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultAPIHost is the host requests are sent to unless overridden.
+const DefaultAPIHost = "https://api.bigcommerce.com"
+
+// DefaultPaymentsHost is the host payments are processed on unless overridden.
+const DefaultPaymentsHost = "https://payments.bigcommerce.com"
+
+const defaultUserAgent = "bigcommerce-api-go"
+
+// Logger is the interface used to log requests. *log.Logger satisfies it.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// Option configures a Client created with NewClient.
+type Option func(*Client)
+
+// WithBaseURL sets the full base URL, including the store path, that request
+// paths are appended to, e.g. "http://localhost:8080/stores/abc123".
+// It takes precedence over WithAPIHost.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithAPIHost sets the scheme and host of the API, keeping the
+// /stores/{store_hash} path. Defaults to DefaultAPIHost.
+func WithAPIHost(host string) Option {
+	return func(c *Client) {
+		c.apiHost = strings.TrimRight(host, "/")
+	}
+}
+
+// WithPaymentsHost sets the scheme and host payments are processed on,
+// keeping the /stores/{store_hash}/payments path. Defaults to DefaultPaymentsHost.
+func WithPaymentsHost(host string) Option {
+	return func(c *Client) {
+		c.paymentsHost = strings.TrimRight(host, "/")
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithDefaultHeader adds a header sent with every request.
+func WithDefaultHeader(key, value string) Option {
+	return func(c *Client) {
+		c.headers.Add(key, value)
+	}
+}
+
+// WithHTTPClient sets the http.Client used to send requests.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.HTTPClient = httpClient
+	}
+}
+
+// WithTimeout limits how long each attempt of a request may take.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// WithRetryPolicy replaces the default retry policy. Pass nil to disable retries.
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(c *Client) {
+		c.RetryPolicy = policy
+	}
+}
+
+// WithLogger logs every request attempt to logger.
+func WithLogger(logger Logger) Option {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+func (c *Client) resolveBaseURL() string {
+	if c.baseURL != "" {
+		return c.baseURL
+	}
+
+	host := c.apiHost
+	if host == "" {
+		host = DefaultAPIHost
+	}
+
+	return fmt.Sprintf("%s/stores/%s", host, c.app.StoreHash)
+}
+
+func (c *Client) paymentsURL() string {
+	host := c.paymentsHost
+	if host == "" {
+		host = DefaultPaymentsHost
+	}
+
+	return fmt.Sprintf("%s/stores/%s/payments", host, c.app.StoreHash)
+}
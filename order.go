@@ -0,0 +1,741 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Order status IDs, as used by Order.StatusID and ListOrdersOptions.StatusID.
+const (
+	OrderStatusIncomplete                 = 0
+	OrderStatusPending                    = 1
+	OrderStatusShipped                    = 2
+	OrderStatusPartiallyShipped           = 3
+	OrderStatusRefunded                   = 4
+	OrderStatusCancelled                  = 5
+	OrderStatusDeclined                   = 6
+	OrderStatusAwaitingPayment            = 7
+	OrderStatusAwaitingPickup             = 8
+	OrderStatusAwaitingShipment           = 9
+	OrderStatusCompleted                  = 10
+	OrderStatusAwaitingFulfillment        = 11
+	OrderStatusManualVerificationRequired = 12
+	OrderStatusDisputed                   = 13
+	OrderStatusPartiallyRefunded          = 14
+)
+
+// OrdersService groups the order services.
+type OrdersService struct {
+	Orders       OrderService
+	Shipments    OrderShipmentService
+	Refunds      OrderRefundService
+	Transactions OrderTransactionService
+}
+
+type OrderService interface {
+	Get(int) (Order, error)
+	GetWithContext(context.Context, int) (Order, error)
+	List(...ListOrdersOptions) ([]Order, error)
+	ListWithContext(context.Context, ...ListOrdersOptions) ([]Order, error)
+	Count(...ListOrdersOptions) (OrderCount, error)
+	CountWithContext(context.Context, ...ListOrdersOptions) (OrderCount, error)
+	Create(OrderRequest) (Order, error)
+	CreateWithContext(context.Context, OrderRequest) (Order, error)
+	Update(int, OrderRequest) (Order, error)
+	UpdateWithContext(context.Context, int, OrderRequest) (Order, error)
+	Archive(int) error
+	ArchiveWithContext(context.Context, int) error
+	Iterate(context.Context, IteratorOptions, ...ListOrdersOptions) *Iterator
+	ListProducts(int, ...ListOptions) ([]OrderProduct, error)
+	ListProductsWithContext(context.Context, int, ...ListOptions) ([]OrderProduct, error)
+	GetProduct(int, int) (OrderProduct, error)
+	GetProductWithContext(context.Context, int, int) (OrderProduct, error)
+	ListShippingAddresses(int, ...ListOptions) ([]OrderShippingAddress, error)
+	ListShippingAddressesWithContext(context.Context, int, ...ListOptions) ([]OrderShippingAddress, error)
+	GetShippingAddress(int, int) (OrderShippingAddress, error)
+	GetShippingAddressWithContext(context.Context, int, int) (OrderShippingAddress, error)
+	UpdateShippingAddress(int, OrderShippingAddress) (OrderShippingAddress, error)
+	UpdateShippingAddressWithContext(context.Context, int, OrderShippingAddress) (OrderShippingAddress, error)
+	ListCoupons(int, ...ListOptions) ([]OrderCoupon, error)
+	ListCouponsWithContext(context.Context, int, ...ListOptions) ([]OrderCoupon, error)
+	ListTaxes(int, ...ListOptions) ([]OrderTax, error)
+	ListTaxesWithContext(context.Context, int, ...ListOptions) ([]OrderTax, error)
+	ListMessages(int, ...ListOptions) ([]OrderMessage, error)
+	ListMessagesWithContext(context.Context, int, ...ListOptions) ([]OrderMessage, error)
+}
+
+// ResourceLink points to a sub-resource of a v2 resource.
+type ResourceLink struct {
+	URL      string `json:"url"`
+	Resource string `json:"resource"`
+}
+
+// Order structure.
+// Amounts are Decimals because the v2 API encodes them as strings.
+type Order struct {
+	ID                     int           `json:"id"`
+	CustomerID             int           `json:"customer_id"`
+	DateCreated            string        `json:"date_created"`
+	DateModified           string        `json:"date_modified"`
+	DateShipped            string        `json:"date_shipped"`
+	StatusID               int           `json:"status_id"`
+	Status                 string        `json:"status"`
+	CustomStatus           string        `json:"custom_status"`
+	SubtotalExTax          Decimal       `json:"subtotal_ex_tax"`
+	SubtotalIncTax         Decimal       `json:"subtotal_inc_tax"`
+	SubtotalTax            Decimal       `json:"subtotal_tax"`
+	BaseShippingCost       Decimal       `json:"base_shipping_cost"`
+	ShippingCostExTax      Decimal       `json:"shipping_cost_ex_tax"`
+	ShippingCostIncTax     Decimal       `json:"shipping_cost_inc_tax"`
+	ShippingCostTax        Decimal       `json:"shipping_cost_tax"`
+	ShippingCostTaxClassID int           `json:"shipping_cost_tax_class_id"`
+	BaseHandlingCost       Decimal       `json:"base_handling_cost"`
+	HandlingCostExTax      Decimal       `json:"handling_cost_ex_tax"`
+	HandlingCostIncTax     Decimal       `json:"handling_cost_inc_tax"`
+	HandlingCostTax        Decimal       `json:"handling_cost_tax"`
+	BaseWrappingCost       Decimal       `json:"base_wrapping_cost"`
+	WrappingCostExTax      Decimal       `json:"wrapping_cost_ex_tax"`
+	WrappingCostIncTax     Decimal       `json:"wrapping_cost_inc_tax"`
+	WrappingCostTax        Decimal       `json:"wrapping_cost_tax"`
+	TotalExTax             Decimal       `json:"total_ex_tax"`
+	TotalIncTax            Decimal       `json:"total_inc_tax"`
+	TotalTax               Decimal       `json:"total_tax"`
+	ItemsTotal             int           `json:"items_total"`
+	ItemsShipped           int           `json:"items_shipped"`
+	PaymentMethod          string        `json:"payment_method"`
+	PaymentProviderID      string        `json:"payment_provider_id"`
+	PaymentStatus          string        `json:"payment_status"`
+	RefundedAmount         Decimal       `json:"refunded_amount"`
+	OrderIsDigital         bool          `json:"order_is_digital"`
+	StoreCreditAmount      Decimal       `json:"store_credit_amount"`
+	GiftCertificateAmount  Decimal       `json:"gift_certificate_amount"`
+	IPAddress              string        `json:"ip_address"`
+	GeoIPCountry           string        `json:"geoip_country"`
+	GeoIPCountryISO2       string        `json:"geoip_country_iso2"`
+	CurrencyID             int           `json:"currency_id"`
+	CurrencyCode           string        `json:"currency_code"`
+	CurrencyExchangeRate   Decimal       `json:"currency_exchange_rate"`
+	DefaultCurrencyID      int           `json:"default_currency_id"`
+	DefaultCurrencyCode    string        `json:"default_currency_code"`
+	StaffNotes             string        `json:"staff_notes"`
+	CustomerMessage        string        `json:"customer_message"`
+	DiscountAmount         Decimal       `json:"discount_amount"`
+	CouponDiscount         Decimal       `json:"coupon_discount"`
+	ShippingAddressCount   int           `json:"shipping_address_count"`
+	IsDeleted              bool          `json:"is_deleted"`
+	IsEmailOptIn           bool          `json:"is_email_opt_in"`
+	CartID                 string        `json:"cart_id"`
+	OrderSource            string        `json:"order_source"`
+	ChannelID              int           `json:"channel_id"`
+	ExternalSource         string        `json:"external_source"`
+	ExternalID             string        `json:"external_id"`
+	CustomerLocale         string        `json:"customer_locale"`
+	BillingAddress         OrderAddress  `json:"billing_address"`
+	Products               *ResourceLink `json:"products"`
+	ShippingAddresses      *ResourceLink `json:"shipping_addresses"`
+	Coupons                *ResourceLink `json:"coupons"`
+}
+
+// OrderRequest is the body used to create or update an order.
+// Amounts left empty are calculated by BigCommerce.
+type OrderRequest struct {
+	CustomerID          int                    `json:"customer_id,omitempty"`
+	StatusID            *int                   `json:"status_id,omitempty"`
+	BillingAddress      *OrderAddress          `json:"billing_address,omitempty"`
+	ShippingAddresses   []OrderShippingAddress `json:"shipping_addresses,omitempty"`
+	Products            []OrderProduct         `json:"products,omitempty"`
+	SubtotalExTax       Decimal                `json:"subtotal_ex_tax,omitempty"`
+	SubtotalIncTax      Decimal                `json:"subtotal_inc_tax,omitempty"`
+	BaseShippingCost    Decimal                `json:"base_shipping_cost,omitempty"`
+	ShippingCostExTax   Decimal                `json:"shipping_cost_ex_tax,omitempty"`
+	ShippingCostIncTax  Decimal                `json:"shipping_cost_inc_tax,omitempty"`
+	TotalExTax          Decimal                `json:"total_ex_tax,omitempty"`
+	TotalIncTax         Decimal                `json:"total_inc_tax,omitempty"`
+	DiscountAmount      Decimal                `json:"discount_amount,omitempty"`
+	PaymentMethod       string                 `json:"payment_method,omitempty"`
+	PaymentProviderID   string                 `json:"payment_provider_id,omitempty"`
+	StaffNotes          string                 `json:"staff_notes,omitempty"`
+	CustomerMessage     string                 `json:"customer_message,omitempty"`
+	CustomerLocale      string                 `json:"customer_locale,omitempty"`
+	ChannelID           int                    `json:"channel_id,omitempty"`
+	ExternalSource      string                 `json:"external_source,omitempty"`
+	ExternalID          string                 `json:"external_id,omitempty"`
+	DefaultCurrencyCode string                 `json:"default_currency_code,omitempty"`
+}
+
+// OrderAddress structure.
+type OrderAddress struct {
+	FirstName   string           `json:"first_name,omitempty"`
+	LastName    string           `json:"last_name,omitempty"`
+	Company     string           `json:"company,omitempty"`
+	Street1     string           `json:"street_1,omitempty"`
+	Street2     string           `json:"street_2,omitempty"`
+	City        string           `json:"city,omitempty"`
+	State       string           `json:"state,omitempty"`
+	Zip         string           `json:"zip,omitempty"`
+	Country     string           `json:"country,omitempty"`
+	CountryISO2 string           `json:"country_iso2,omitempty"`
+	Phone       string           `json:"phone,omitempty"`
+	Email       string           `json:"email,omitempty"`
+	FormFields  []OrderFormField `json:"form_fields,omitempty"`
+}
+
+// OrderFormField is the value of a custom address form field.
+type OrderFormField struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// OrderShippingAddress structure.
+type OrderShippingAddress struct {
+	OrderAddress
+	ID                 int           `json:"id,omitempty"`
+	OrderID            int           `json:"order_id,omitempty"`
+	ItemsTotal         int           `json:"items_total,omitempty"`
+	ItemsShipped       int           `json:"items_shipped,omitempty"`
+	ShippingMethod     string        `json:"shipping_method,omitempty"`
+	BaseCost           Decimal       `json:"base_cost,omitempty"`
+	CostExTax          Decimal       `json:"cost_ex_tax,omitempty"`
+	CostIncTax         Decimal       `json:"cost_inc_tax,omitempty"`
+	CostTax            Decimal       `json:"cost_tax,omitempty"`
+	CostTaxClassID     int           `json:"cost_tax_class_id,omitempty"`
+	BaseHandlingCost   Decimal       `json:"base_handling_cost,omitempty"`
+	HandlingCostExTax  Decimal       `json:"handling_cost_ex_tax,omitempty"`
+	HandlingCostIncTax Decimal       `json:"handling_cost_inc_tax,omitempty"`
+	HandlingCostTax    Decimal       `json:"handling_cost_tax,omitempty"`
+	ShippingZoneID     int           `json:"shipping_zone_id,omitempty"`
+	ShippingZoneName   string        `json:"shipping_zone_name,omitempty"`
+	ShippingQuotes     *ResourceLink `json:"shipping_quotes,omitempty"`
+}
+
+// OrderProduct structure.
+// When creating an order, set ProductID, Quantity and ProductOptions for
+// catalog products, or Name, Quantity and the prices for custom products.
+type OrderProduct struct {
+	ID                   int                  `json:"id,omitempty"`
+	OrderID              int                  `json:"order_id,omitempty"`
+	ProductID            int                  `json:"product_id,omitempty"`
+	VariantID            int                  `json:"variant_id,omitempty"`
+	OrderAddressID       int                  `json:"order_address_id,omitempty"`
+	Name                 string               `json:"name,omitempty"`
+	NameCustomer         string               `json:"name_customer,omitempty"`
+	NameMerchant         string               `json:"name_merchant,omitempty"`
+	SKU                  string               `json:"sku,omitempty"`
+	UPC                  string               `json:"upc,omitempty"`
+	Type                 string               `json:"type,omitempty"`
+	BasePrice            Decimal              `json:"base_price,omitempty"`
+	PriceExTax           Decimal              `json:"price_ex_tax,omitempty"`
+	PriceIncTax          Decimal              `json:"price_inc_tax,omitempty"`
+	PriceTax             Decimal              `json:"price_tax,omitempty"`
+	BaseTotal            Decimal              `json:"base_total,omitempty"`
+	TotalExTax           Decimal              `json:"total_ex_tax,omitempty"`
+	TotalIncTax          Decimal              `json:"total_inc_tax,omitempty"`
+	TotalTax             Decimal              `json:"total_tax,omitempty"`
+	BaseCostPrice        Decimal              `json:"base_cost_price,omitempty"`
+	Weight               Decimal              `json:"weight,omitempty"`
+	Quantity             int                  `json:"quantity,omitempty"`
+	QuantityShipped      int                  `json:"quantity_shipped,omitempty"`
+	QuantityRefunded     int                  `json:"quantity_refunded,omitempty"`
+	IsRefunded           bool                 `json:"is_refunded,omitempty"`
+	RefundAmount         Decimal              `json:"refund_amount,omitempty"`
+	ReturnID             int                  `json:"return_id,omitempty"`
+	WrappingName         string               `json:"wrapping_name,omitempty"`
+	FixedShippingCost    Decimal              `json:"fixed_shipping_cost,omitempty"`
+	OptionSetID          int                  `json:"option_set_id,omitempty"`
+	ParentOrderProductID int                  `json:"parent_order_product_id,omitempty"`
+	IsBundledProduct     bool                 `json:"is_bundled_product,omitempty"`
+	BinPickingNumber     string               `json:"bin_picking_number,omitempty"`
+	ExternalID           string               `json:"external_id,omitempty"`
+	FulfillmentSource    string               `json:"fulfillment_source,omitempty"`
+	Brand                string               `json:"brand,omitempty"`
+	ProductOptions       []OrderProductOption `json:"product_options,omitempty"`
+}
+
+// OrderProductOption is an option chosen for an order product.
+// When creating an order only ID (the product option ID) and Value are used.
+type OrderProductOption struct {
+	ID              int    `json:"id,omitempty"`
+	OptionID        int    `json:"option_id,omitempty"`
+	OrderProductID  int    `json:"order_product_id,omitempty"`
+	ProductOptionID int    `json:"product_option_id,omitempty"`
+	DisplayName     string `json:"display_name,omitempty"`
+	DisplayValue    string `json:"display_value,omitempty"`
+	Value           string `json:"value,omitempty"`
+	Type            string `json:"type,omitempty"`
+	Name            string `json:"name,omitempty"`
+}
+
+// OrderCoupon structure.
+type OrderCoupon struct {
+	ID       int     `json:"id"`
+	CouponID int     `json:"coupon_id"`
+	OrderID  int     `json:"order_id"`
+	Code     string  `json:"code"`
+	Amount   Decimal `json:"amount"`
+	Type     int     `json:"type"`
+	Discount Decimal `json:"discount"`
+}
+
+// OrderTax structure.
+type OrderTax struct {
+	ID             int     `json:"id"`
+	OrderID        int     `json:"order_id"`
+	OrderAddressID int     `json:"order_address_id"`
+	OrderProductID int     `json:"order_product_id"`
+	TaxRateID      int     `json:"tax_rate_id"`
+	TaxClassID     int     `json:"tax_class_id"`
+	Name           string  `json:"name"`
+	Class          string  `json:"class"`
+	Rate           Decimal `json:"rate"`
+	Priority       int     `json:"priority"`
+	PriorityAmount Decimal `json:"priority_amount"`
+	LineAmount     Decimal `json:"line_amount"`
+	LineItemType   string  `json:"line_item_type"`
+}
+
+// OrderMessage structure.
+type OrderMessage struct {
+	ID          int    `json:"id"`
+	OrderID     int    `json:"order_id"`
+	StaffID     int    `json:"staff_id"`
+	CustomerID  int    `json:"customer_id"`
+	Type        string `json:"type"`
+	Subject     string `json:"subject"`
+	Message     string `json:"message"`
+	Status      string `json:"status"`
+	IsFlagged   bool   `json:"is_flagged"`
+	DateCreated string `json:"date_created"`
+}
+
+// OrderCount is the number of orders, in total and per status.
+type OrderCount struct {
+	Count    int                `json:"count"`
+	Statuses []OrderStatusCount `json:"statuses"`
+}
+
+// OrderStatusCount is the number of orders in a status.
+type OrderStatusCount struct {
+	ID                int    `json:"id"`
+	Name              string `json:"name"`
+	SystemLabel       string `json:"system_label"`
+	CustomLabel       string `json:"custom_label"`
+	SystemDescription string `json:"system_description"`
+	Count             int    `json:"count"`
+	SortOrder         int    `json:"sort_order"`
+}
+
+// ListOrdersOptions filters the orders returned by List and Count.
+type ListOrdersOptions struct {
+	ListOptions
+	MinID           int       `url:"min_id,omitempty"`
+	MaxID           int       `url:"max_id,omitempty"`
+	MinTotal        float64   `url:"min_total,omitempty"`
+	MaxTotal        float64   `url:"max_total,omitempty"`
+	CustomerID      int       `url:"customer_id,omitempty"`
+	Email           string    `url:"email,omitempty"`
+	StatusID        *int      `url:"status_id,omitempty"`
+	CartID          string    `url:"cart_id,omitempty"`
+	PaymentMethod   string    `url:"payment_method,omitempty"`
+	MinDateCreated  time.Time `url:"min_date_created,omitempty"`
+	MaxDateCreated  time.Time `url:"max_date_created,omitempty"`
+	MinDateModified time.Time `url:"min_date_modified,omitempty"`
+	MaxDateModified time.Time `url:"max_date_modified,omitempty"`
+	IsDeleted       *bool     `url:"is_deleted,omitempty"`
+	ChannelID       int       `url:"channel_id,omitempty"`
+	Sort            string    `url:"sort,omitempty"`
+}
+
+type OrderServiceOp struct {
+	client *Client
+}
+
+// Get will fetch a single order by the provided ID.
+func (s *OrderServiceOp) Get(id int) (Order, error) {
+	return s.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *OrderServiceOp) GetWithContext(ctx context.Context, id int) (Order, error) {
+	order := Order{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v2/orders/%d", id), nil)
+	if reqErr != nil {
+		return order, reqErr
+	}
+
+	jsonErr := unmarshalOptional(body, &order)
+	if jsonErr != nil {
+		return order, jsonErr
+	}
+	return order, nil
+}
+
+// List will return a page of orders matching the options.
+func (s *OrderServiceOp) List(options ...ListOrdersOptions) ([]Order, error) {
+	return s.ListWithContext(context.Background(), options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *OrderServiceOp) ListWithContext(ctx context.Context, options ...ListOrdersOptions) ([]Order, error) {
+	orders := []Order{}
+
+	var listOptions ListOrdersOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v2/orders", listOptions)
+	if err != nil {
+		return orders, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return orders, reqErr
+	}
+
+	jsonErr := unmarshalOptional(body, &orders)
+	if jsonErr != nil {
+		return orders, jsonErr
+	}
+	return orders, nil
+}
+
+// Count will return the number of orders matching the options, in total and per status.
+func (s *OrderServiceOp) Count(options ...ListOrdersOptions) (OrderCount, error) {
+	return s.CountWithContext(context.Background(), options...)
+}
+
+// CountWithContext is the context-aware variant of Count.
+func (s *OrderServiceOp) CountWithContext(ctx context.Context, options ...ListOrdersOptions) (OrderCount, error) {
+	count := OrderCount{}
+
+	var listOptions ListOrdersOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v2/orders/count", listOptions)
+	if err != nil {
+		return count, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return count, reqErr
+	}
+
+	jsonErr := unmarshalOptional(body, &count)
+	if jsonErr != nil {
+		return count, jsonErr
+	}
+	return count, nil
+}
+
+// Create will create a new order.
+// The fields required on an order are: BillingAddress and Products.
+func (s *OrderServiceOp) Create(order OrderRequest) (Order, error) {
+	return s.CreateWithContext(context.Background(), order)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *OrderServiceOp) CreateWithContext(ctx context.Context, order OrderRequest) (Order, error) {
+	created := Order{}
+	jsonBody, err := json.Marshal(order)
+	if err != nil {
+		return created, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, "/v2/orders", reqBody)
+	if reqErr != nil {
+		return created, reqErr
+	}
+
+	jsonErr := unmarshalOptional(body, &created)
+	if jsonErr != nil {
+		return created, jsonErr
+	}
+	return created, nil
+}
+
+// Update will update an existing order.
+func (s *OrderServiceOp) Update(orderID int, order OrderRequest) (Order, error) {
+	return s.UpdateWithContext(context.Background(), orderID, order)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *OrderServiceOp) UpdateWithContext(ctx context.Context, orderID int, order OrderRequest) (Order, error) {
+	updated := Order{}
+	jsonBody, err := json.Marshal(order)
+	if err != nil {
+		return updated, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v2/orders/%d", orderID), reqBody)
+	if reqErr != nil {
+		return updated, reqErr
+	}
+
+	jsonErr := unmarshalOptional(body, &updated)
+	if jsonErr != nil {
+		return updated, jsonErr
+	}
+	return updated, nil
+}
+
+// Archive will archive an order. Archived orders can be restored from the control panel.
+func (s *OrderServiceOp) Archive(id int) error {
+	return s.ArchiveWithContext(context.Background(), id)
+}
+
+// ArchiveWithContext is the context-aware variant of Archive.
+func (s *OrderServiceOp) ArchiveWithContext(ctx context.Context, id int) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v2/orders/%d", id), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// Iterate will walk every order matching the options, fetching further pages as needed.
+func (s *OrderServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, options ...ListOrdersOptions) *Iterator {
+	var listOptions ListOrdersOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		items, err := s.ListWithContext(ctx, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return items, v2Page{count: len(items), limit: limit}, nil
+	}, iteratorOptions)
+}
+
+// ListProducts will return a page of the products of an order.
+func (s *OrderServiceOp) ListProducts(orderID int, options ...ListOptions) ([]OrderProduct, error) {
+	return s.ListProductsWithContext(context.Background(), orderID, options...)
+}
+
+// ListProductsWithContext is the context-aware variant of ListProducts.
+func (s *OrderServiceOp) ListProductsWithContext(ctx context.Context, orderID int, options ...ListOptions) ([]OrderProduct, error) {
+	products := []OrderProduct{}
+
+	var listOptions ListOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v2/orders/%d/products", orderID), listOptions)
+	if err != nil {
+		return products, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return products, reqErr
+	}
+
+	jsonErr := unmarshalOptional(body, &products)
+	if jsonErr != nil {
+		return products, jsonErr
+	}
+	return products, nil
+}
+
+// GetProduct will fetch a single product of an order.
+func (s *OrderServiceOp) GetProduct(orderID, productID int) (OrderProduct, error) {
+	return s.GetProductWithContext(context.Background(), orderID, productID)
+}
+
+// GetProductWithContext is the context-aware variant of GetProduct.
+func (s *OrderServiceOp) GetProductWithContext(ctx context.Context, orderID, productID int) (OrderProduct, error) {
+	product := OrderProduct{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v2/orders/%d/products/%d", orderID, productID), nil)
+	if reqErr != nil {
+		return product, reqErr
+	}
+
+	jsonErr := unmarshalOptional(body, &product)
+	if jsonErr != nil {
+		return product, jsonErr
+	}
+	return product, nil
+}
+
+// ListShippingAddresses will return a page of the shipping addresses of an order.
+func (s *OrderServiceOp) ListShippingAddresses(orderID int, options ...ListOptions) ([]OrderShippingAddress, error) {
+	return s.ListShippingAddressesWithContext(context.Background(), orderID, options...)
+}
+
+// ListShippingAddressesWithContext is the context-aware variant of ListShippingAddresses.
+func (s *OrderServiceOp) ListShippingAddressesWithContext(ctx context.Context, orderID int, options ...ListOptions) ([]OrderShippingAddress, error) {
+	addresses := []OrderShippingAddress{}
+
+	var listOptions ListOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v2/orders/%d/shipping_addresses", orderID), listOptions)
+	if err != nil {
+		return addresses, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return addresses, reqErr
+	}
+
+	jsonErr := unmarshalOptional(body, &addresses)
+	if jsonErr != nil {
+		return addresses, jsonErr
+	}
+	return addresses, nil
+}
+
+// GetShippingAddress will fetch a single shipping address of an order.
+func (s *OrderServiceOp) GetShippingAddress(orderID, addressID int) (OrderShippingAddress, error) {
+	return s.GetShippingAddressWithContext(context.Background(), orderID, addressID)
+}
+
+// GetShippingAddressWithContext is the context-aware variant of GetShippingAddress.
+func (s *OrderServiceOp) GetShippingAddressWithContext(ctx context.Context, orderID, addressID int) (OrderShippingAddress, error) {
+	address := OrderShippingAddress{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v2/orders/%d/shipping_addresses/%d", orderID, addressID), nil)
+	if reqErr != nil {
+		return address, reqErr
+	}
+
+	jsonErr := unmarshalOptional(body, &address)
+	if jsonErr != nil {
+		return address, jsonErr
+	}
+	return address, nil
+}
+
+// UpdateShippingAddress will update a single shipping address of an order.
+func (s *OrderServiceOp) UpdateShippingAddress(orderID int, address OrderShippingAddress) (OrderShippingAddress, error) {
+	return s.UpdateShippingAddressWithContext(context.Background(), orderID, address)
+}
+
+// UpdateShippingAddressWithContext is the context-aware variant of UpdateShippingAddress.
+func (s *OrderServiceOp) UpdateShippingAddressWithContext(ctx context.Context, orderID int, address OrderShippingAddress) (OrderShippingAddress, error) {
+	updated := OrderShippingAddress{}
+	jsonBody, err := json.Marshal(address)
+	if err != nil {
+		return updated, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v2/orders/%d/shipping_addresses/%d", orderID, address.ID), reqBody)
+	if reqErr != nil {
+		return updated, reqErr
+	}
+
+	jsonErr := unmarshalOptional(body, &updated)
+	if jsonErr != nil {
+		return updated, jsonErr
+	}
+	return updated, nil
+}
+
+// ListCoupons will return a page of the coupons applied to an order.
+func (s *OrderServiceOp) ListCoupons(orderID int, options ...ListOptions) ([]OrderCoupon, error) {
+	return s.ListCouponsWithContext(context.Background(), orderID, options...)
+}
+
+// ListCouponsWithContext is the context-aware variant of ListCoupons.
+func (s *OrderServiceOp) ListCouponsWithContext(ctx context.Context, orderID int, options ...ListOptions) ([]OrderCoupon, error) {
+	coupons := []OrderCoupon{}
+
+	var listOptions ListOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v2/orders/%d/coupons", orderID), listOptions)
+	if err != nil {
+		return coupons, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return coupons, reqErr
+	}
+
+	jsonErr := unmarshalOptional(body, &coupons)
+	if jsonErr != nil {
+		return coupons, jsonErr
+	}
+	return coupons, nil
+}
+
+// ListTaxes will return a page of the taxes applied to an order.
+func (s *OrderServiceOp) ListTaxes(orderID int, options ...ListOptions) ([]OrderTax, error) {
+	return s.ListTaxesWithContext(context.Background(), orderID, options...)
+}
+
+// ListTaxesWithContext is the context-aware variant of ListTaxes.
+func (s *OrderServiceOp) ListTaxesWithContext(ctx context.Context, orderID int, options ...ListOptions) ([]OrderTax, error) {
+	taxes := []OrderTax{}
+
+	var listOptions ListOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v2/orders/%d/taxes", orderID), listOptions)
+	if err != nil {
+		return taxes, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return taxes, reqErr
+	}
+
+	jsonErr := unmarshalOptional(body, &taxes)
+	if jsonErr != nil {
+		return taxes, jsonErr
+	}
+	return taxes, nil
+}
+
+// ListMessages will return a page of the messages of an order.
+func (s *OrderServiceOp) ListMessages(orderID int, options ...ListOptions) ([]OrderMessage, error) {
+	return s.ListMessagesWithContext(context.Background(), orderID, options...)
+}
+
+// ListMessagesWithContext is the context-aware variant of ListMessages.
+func (s *OrderServiceOp) ListMessagesWithContext(ctx context.Context, orderID int, options ...ListOptions) ([]OrderMessage, error) {
+	messages := []OrderMessage{}
+
+	var listOptions ListOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v2/orders/%d/messages", orderID), listOptions)
+	if err != nil {
+		return messages, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return messages, reqErr
+	}
+
+	jsonErr := unmarshalOptional(body, &messages)
+	if jsonErr != nil {
+		return messages, jsonErr
+	}
+	return messages, nil
+}
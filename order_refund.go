@@ -0,0 +1,338 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Refund item types, as used by RefundItem.ItemType.
+const (
+	RefundItemTypeProduct      = "PRODUCT"
+	RefundItemTypeOrder        = "ORDER"
+	RefundItemTypeShipping     = "SHIPPING"
+	RefundItemTypeHandling     = "HANDLING"
+	RefundItemTypeGiftWrapping = "GIFT_WRAPPING"
+)
+
+type OrderRefundService interface {
+	Quote(int, RefundQuoteRequest) (RefundQuote, error)
+	QuoteWithContext(context.Context, int, RefundQuoteRequest) (RefundQuote, error)
+	Create(int, RefundRequest) (Refund, error)
+	CreateWithContext(context.Context, int, RefundRequest) (Refund, error)
+	List(int, ...ListOptions) (ListRefundResponse, error)
+	ListWithContext(context.Context, int, ...ListOptions) (ListRefundResponse, error)
+	ListAll(...ListRefundsOptions) (ListRefundResponse, error)
+	ListAllWithContext(context.Context, ...ListRefundsOptions) (ListRefundResponse, error)
+	Iterate(context.Context, IteratorOptions, ...ListRefundsOptions) *Iterator
+}
+
+type GetRefundQuoteResponse struct {
+	Data RefundQuote `json:"data"`
+}
+
+type GetRefundResponse struct {
+	Data Refund `json:"data"`
+}
+
+type ListRefundResponse struct {
+	Data []Refund   `json:"data"`
+	Meta MetaResult `json:"meta"`
+}
+
+// RefundItem is an item of an order to refund.
+// Products are refunded by Quantity, the other item types by Amount.
+type RefundItem struct {
+	ItemType        string  `json:"item_type"`
+	ItemID          int     `json:"item_id"`
+	Quantity        int     `json:"quantity,omitempty"`
+	Amount          float64 `json:"amount,omitempty"`
+	RequestedAmount float64 `json:"requested_amount,omitempty"`
+	Reason          string  `json:"reason,omitempty"`
+}
+
+// RefundQuoteRequest lists the items to calculate a refund quote for.
+type RefundQuoteRequest struct {
+	Items []RefundItem `json:"items"`
+}
+
+// RefundQuote structure.
+// Each entry of RefundMethods is one way to pay out TotalRefundAmount,
+// possibly split across several payment providers.
+type RefundQuote struct {
+	OrderID              int              `json:"order_id"`
+	TotalRefundAmount    float64          `json:"total_refund_amount"`
+	TotalRefundTaxAmount float64          `json:"total_refund_tax_amount"`
+	Rounding             float64          `json:"rounding"`
+	Adjustment           float64          `json:"adjustment"`
+	TaxInclusive         bool             `json:"tax_inclusive"`
+	RefundMethods        [][]RefundMethod `json:"refund_methods"`
+}
+
+// RefundMethod is the amount that can be refunded through a payment provider.
+type RefundMethod struct {
+	ProviderID          string  `json:"provider_id"`
+	ProviderDescription string  `json:"provider_description"`
+	Amount              float64 `json:"amount"`
+	Offline             bool    `json:"offline"`
+	OfflineProvider     bool    `json:"offline_provider"`
+	OfflineReason       string  `json:"offline_reason"`
+}
+
+// Payment returns the refund payment that pays out the method in full.
+func (m RefundMethod) Payment() RefundPayment {
+	return RefundPayment{ProviderID: m.ProviderID, Amount: m.Amount, Offline: m.Offline}
+}
+
+// Validate will return a *RefundAmountError when payments add up to more
+// than the quoted refundable amount.
+func (q RefundQuote) Validate(payments []RefundPayment) error {
+	requested := sumRefundPayments(payments)
+	if requested > q.TotalRefundAmount+0.005 {
+		return &RefundAmountError{OrderID: q.OrderID, Requested: requested, Refundable: q.TotalRefundAmount}
+	}
+	return nil
+}
+
+// RefundRequest is the body used to create a refund.
+type RefundRequest struct {
+	Items                      []RefundItem    `json:"items"`
+	Payments                   []RefundPayment `json:"payments"`
+	MerchantCalculatedOverride *RefundOverride `json:"merchant_calculated_override,omitempty"`
+}
+
+// RefundOverride replaces the refund totals calculated by BigCommerce.
+type RefundOverride struct {
+	TotalAmount float64 `json:"total_amount"`
+	TotalTax    float64 `json:"total_tax"`
+}
+
+// RefundPayment is the amount refunded through a payment provider.
+type RefundPayment struct {
+	ID              int     `json:"id,omitempty"`
+	ProviderID      string  `json:"provider_id"`
+	Amount          float64 `json:"amount"`
+	Offline         bool    `json:"offline"`
+	IsDeclined      bool    `json:"is_declined,omitempty"`
+	DeclinedMessage string  `json:"declined_message,omitempty"`
+}
+
+// Refund structure.
+type Refund struct {
+	ID                         int             `json:"id"`
+	OrderID                    int             `json:"order_id"`
+	UserID                     int             `json:"user_id"`
+	Created                    string          `json:"created"`
+	Reason                     string          `json:"reason"`
+	TotalAmount                float64         `json:"total_amount"`
+	TotalTax                   float64         `json:"total_tax"`
+	UsesMerchantOverrideValues bool            `json:"uses_merchant_override_values"`
+	Payments                   []RefundPayment `json:"payments"`
+	Items                      []RefundItem    `json:"items"`
+}
+
+// ListRefundsOptions filters the refunds returned by ListAll.
+type ListRefundsOptions struct {
+	ListOptions
+	OrderIDIn  []int     `url:"order_id:in,omitempty"`
+	IDIn       []int     `url:"id:in,omitempty"`
+	CreatedMin time.Time `url:"created:min,omitempty"`
+	CreatedMax time.Time `url:"created:max,omitempty"`
+}
+
+// RefundAmountError is returned when a refund asks for more than the
+// refundable amount of an order. Err holds the API error, if any.
+type RefundAmountError struct {
+	OrderID    int
+	Requested  float64
+	Refundable float64
+	Err        error
+}
+
+func (e *RefundAmountError) Error() string {
+	msg := fmt.Sprintf("bigcommerce: refund for order %d exceeds the refundable amount (requested %.2f, refundable %.2f)", e.OrderID, e.Requested, e.Refundable)
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return msg
+}
+
+func (e *RefundAmountError) Unwrap() error {
+	return e.Err
+}
+
+// IsRefundAmountExceeded reports whether err is a *RefundAmountError.
+func IsRefundAmountExceeded(err error) bool {
+	var amountErr *RefundAmountError
+	return errors.As(err, &amountErr)
+}
+
+// refundError checks a 422 response to a refund against a fresh quote for
+// the same items, returning a *RefundAmountError when the payments exceed the
+// quoted refundable amount and err unchanged otherwise.
+func (s *OrderRefundServiceOp) refundError(ctx context.Context, orderID int, request RefundRequest, err error) error {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnprocessableEntity {
+		return err
+	}
+
+	quote, quoteErr := s.QuoteWithContext(ctx, orderID, RefundQuoteRequest{Items: request.Items})
+	if quoteErr != nil {
+		return err
+	}
+	if amountErr, ok := quote.Validate(request.Payments).(*RefundAmountError); ok {
+		amountErr.Err = err
+		return amountErr
+	}
+	return err
+}
+
+func sumRefundPayments(payments []RefundPayment) float64 {
+	total := 0.0
+	for _, payment := range payments {
+		total += payment.Amount
+	}
+	return total
+}
+
+type OrderRefundServiceOp struct {
+	client *Client
+}
+
+// Quote will calculate the amounts, and the payment methods, available to refund the given items.
+func (s *OrderRefundServiceOp) Quote(orderID int, request RefundQuoteRequest) (RefundQuote, error) {
+	return s.QuoteWithContext(context.Background(), orderID, request)
+}
+
+// QuoteWithContext is the context-aware variant of Quote.
+func (s *OrderRefundServiceOp) QuoteWithContext(ctx context.Context, orderID int, request RefundQuoteRequest) (RefundQuote, error) {
+	quoteResponse := GetRefundQuoteResponse{}
+	jsonBody, err := json.Marshal(request)
+	if err != nil {
+		return quoteResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("/v3/orders/%d/payment_actions/refund_quotes", orderID), reqBody)
+	if reqErr != nil {
+		return quoteResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &quoteResponse)
+	if jsonErr != nil {
+		return quoteResponse.Data, jsonErr
+	}
+	return quoteResponse.Data, nil
+}
+
+// Create will refund the given items of an order through the given payments.
+// When a rejected request pays out more than a fresh quote allows, the error
+// is a *RefundAmountError.
+func (s *OrderRefundServiceOp) Create(orderID int, request RefundRequest) (Refund, error) {
+	return s.CreateWithContext(context.Background(), orderID, request)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *OrderRefundServiceOp) CreateWithContext(ctx context.Context, orderID int, request RefundRequest) (Refund, error) {
+	refundResponse := GetRefundResponse{}
+	jsonBody, err := json.Marshal(request)
+	if err != nil {
+		return refundResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("/v3/orders/%d/payment_actions/refunds", orderID), reqBody)
+	if reqErr != nil {
+		return refundResponse.Data, s.refundError(ctx, orderID, request, reqErr)
+	}
+
+	jsonErr := json.Unmarshal(body, &refundResponse)
+	if jsonErr != nil {
+		return refundResponse.Data, jsonErr
+	}
+	return refundResponse.Data, nil
+}
+
+// List will return a page of the refunds of an order.
+func (s *OrderRefundServiceOp) List(orderID int, options ...ListOptions) (ListRefundResponse, error) {
+	return s.ListWithContext(context.Background(), orderID, options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *OrderRefundServiceOp) ListWithContext(ctx context.Context, orderID int, options ...ListOptions) (ListRefundResponse, error) {
+	refundResponse := ListRefundResponse{}
+
+	var listOptions ListOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/orders/%d/payment_actions/refunds", orderID), listOptions)
+	if err != nil {
+		return refundResponse, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return refundResponse, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &refundResponse)
+	if jsonErr != nil {
+		return refundResponse, jsonErr
+	}
+	return refundResponse, nil
+}
+
+// ListAll will return a page of the refunds of every order matching the options.
+func (s *OrderRefundServiceOp) ListAll(options ...ListRefundsOptions) (ListRefundResponse, error) {
+	return s.ListAllWithContext(context.Background(), options...)
+}
+
+// ListAllWithContext is the context-aware variant of ListAll.
+func (s *OrderRefundServiceOp) ListAllWithContext(ctx context.Context, options ...ListRefundsOptions) (ListRefundResponse, error) {
+	refundResponse := ListRefundResponse{}
+
+	var listOptions ListRefundsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/orders/payment_actions/refunds", listOptions)
+	if err != nil {
+		return refundResponse, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return refundResponse, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &refundResponse)
+	if jsonErr != nil {
+		return refundResponse, jsonErr
+	}
+	return refundResponse, nil
+}
+
+// Iterate will walk every refund matching the options, fetching further pages as needed.
+func (s *OrderRefundServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, options ...ListRefundsOptions) *Iterator {
+	var listOptions ListRefundsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListAllWithContext(ctx, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
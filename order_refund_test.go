@@ -0,0 +1,61 @@
+package bigcommerce
+
+import (
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestOrderRefundCreateChecksRejectionsAgainstQuote(t *testing.T) {
+	tests := []struct {
+		name           string
+		refundStatus   int
+		refundable     string
+		wantAmountErr  bool
+		wantQuoteCalls int32
+	}{
+		{"payments exceed quote", http.StatusUnprocessableEntity, "10.00", true, 1},
+		{"payments within quote", http.StatusUnprocessableEntity, "50.00", false, 1},
+		{"other failure", http.StatusInternalServerError, "10.00", false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var quoteCalls int32
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/v3/orders/5/payment_actions/refunds":
+					w.WriteHeader(tt.refundStatus)
+					w.Write([]byte(`{"status":422,"title":"The refund could not be processed"}`))
+				case "/v3/orders/5/payment_actions/refund_quotes":
+					atomic.AddInt32(&quoteCalls, 1)
+					w.Write([]byte(`{"data":{"order_id":5,"total_refund_amount":` + tt.refundable + `}}`))
+				default:
+					t.Errorf("unexpected request to %s", r.URL.Path)
+				}
+			})
+
+			request := RefundRequest{
+				Items:    []RefundItem{{ItemType: RefundItemTypeProduct, ItemID: 1, Quantity: 1}},
+				Payments: []RefundPayment{{ProviderID: "storecredit", Amount: 25}},
+			}
+			_, err := client.Orders.Refunds.Create(5, request)
+
+			var amountErr *RefundAmountError
+			if got := errors.As(err, &amountErr); got != tt.wantAmountErr {
+				t.Fatalf("err = %v, want RefundAmountError %v", err, tt.wantAmountErr)
+			}
+			if tt.wantAmountErr && (amountErr.Requested != 25 || amountErr.Refundable != 10) {
+				t.Errorf("RefundAmountError = %+v", amountErr)
+			}
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != tt.refundStatus {
+				t.Errorf("err = %v, want it to wrap the %d APIError", err, tt.refundStatus)
+			}
+			if quoteCalls != tt.wantQuoteCalls {
+				t.Errorf("quote calls = %d, want %d", quoteCalls, tt.wantQuoteCalls)
+			}
+		})
+	}
+}
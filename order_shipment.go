@@ -0,0 +1,209 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type OrderShipmentService interface {
+	List(int, ...ListOptions) ([]OrderShipment, error)
+	ListWithContext(context.Context, int, ...ListOptions) ([]OrderShipment, error)
+	Get(int, int) (OrderShipment, error)
+	GetWithContext(context.Context, int, int) (OrderShipment, error)
+	Count(int) (int, error)
+	CountWithContext(context.Context, int) (int, error)
+	Create(int, OrderShipment) (OrderShipment, error)
+	CreateWithContext(context.Context, int, OrderShipment) (OrderShipment, error)
+	Update(int, OrderShipment) (OrderShipment, error)
+	UpdateWithContext(context.Context, int, OrderShipment) (OrderShipment, error)
+	Delete(int, int) error
+	DeleteWithContext(context.Context, int, int) error
+	DeleteAll(int) error
+	DeleteAllWithContext(context.Context, int) error
+}
+
+// OrderShipment structure.
+type OrderShipment struct {
+	ID               int                 `json:"id,omitempty"`
+	OrderID          int                 `json:"order_id,omitempty"`
+	CustomerID       int                 `json:"customer_id,omitempty"`
+	OrderAddressID   int                 `json:"order_address_id,omitempty"`
+	DateCreated      string              `json:"date_created,omitempty"`
+	TrackingNumber   string              `json:"tracking_number,omitempty"`
+	ShippingMethod   string              `json:"shipping_method,omitempty"`
+	ShippingProvider string              `json:"shipping_provider,omitempty"`
+	TrackingCarrier  string              `json:"tracking_carrier,omitempty"`
+	TrackingLink     string              `json:"tracking_link,omitempty"`
+	Comments         string              `json:"comments,omitempty"`
+	BillingAddress   *OrderAddress       `json:"billing_address,omitempty"`
+	ShippingAddress  *OrderAddress       `json:"shipping_address,omitempty"`
+	Items            []OrderShipmentItem `json:"items,omitempty"`
+}
+
+// OrderShipmentItem is an order product, and the quantity of it, in a shipment.
+type OrderShipmentItem struct {
+	OrderProductID int `json:"order_product_id"`
+	ProductID      int `json:"product_id,omitempty"`
+	Quantity       int `json:"quantity"`
+}
+
+type orderShipmentCount struct {
+	Count int `json:"count"`
+}
+
+type OrderShipmentServiceOp struct {
+	client *Client
+}
+
+// List will return a page of the shipments of an order.
+func (s *OrderShipmentServiceOp) List(orderID int, options ...ListOptions) ([]OrderShipment, error) {
+	return s.ListWithContext(context.Background(), orderID, options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *OrderShipmentServiceOp) ListWithContext(ctx context.Context, orderID int, options ...ListOptions) ([]OrderShipment, error) {
+	shipments := []OrderShipment{}
+
+	var listOptions ListOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v2/orders/%d/shipments", orderID), listOptions)
+	if err != nil {
+		return shipments, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return shipments, reqErr
+	}
+
+	jsonErr := unmarshalOptional(body, &shipments)
+	if jsonErr != nil {
+		return shipments, jsonErr
+	}
+	return shipments, nil
+}
+
+// Get will fetch a single shipment of an order.
+func (s *OrderShipmentServiceOp) Get(orderID, shipmentID int) (OrderShipment, error) {
+	return s.GetWithContext(context.Background(), orderID, shipmentID)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *OrderShipmentServiceOp) GetWithContext(ctx context.Context, orderID, shipmentID int) (OrderShipment, error) {
+	shipment := OrderShipment{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v2/orders/%d/shipments/%d", orderID, shipmentID), nil)
+	if reqErr != nil {
+		return shipment, reqErr
+	}
+
+	jsonErr := unmarshalOptional(body, &shipment)
+	if jsonErr != nil {
+		return shipment, jsonErr
+	}
+	return shipment, nil
+}
+
+// Count will return the number of shipments of an order.
+func (s *OrderShipmentServiceOp) Count(orderID int) (int, error) {
+	return s.CountWithContext(context.Background(), orderID)
+}
+
+// CountWithContext is the context-aware variant of Count.
+func (s *OrderShipmentServiceOp) CountWithContext(ctx context.Context, orderID int) (int, error) {
+	count := orderShipmentCount{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v2/orders/%d/shipments/count", orderID), nil)
+	if reqErr != nil {
+		return 0, reqErr
+	}
+
+	jsonErr := unmarshalOptional(body, &count)
+	if jsonErr != nil {
+		return 0, jsonErr
+	}
+	return count.Count, nil
+}
+
+// Create will create a shipment for an order.
+// The fields required on a shipment are: OrderAddressID and Items.
+func (s *OrderShipmentServiceOp) Create(orderID int, shipment OrderShipment) (OrderShipment, error) {
+	return s.CreateWithContext(context.Background(), orderID, shipment)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *OrderShipmentServiceOp) CreateWithContext(ctx context.Context, orderID int, shipment OrderShipment) (OrderShipment, error) {
+	created := OrderShipment{}
+	jsonBody, err := json.Marshal(shipment)
+	if err != nil {
+		return created, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("/v2/orders/%d/shipments", orderID), reqBody)
+	if reqErr != nil {
+		return created, reqErr
+	}
+
+	jsonErr := unmarshalOptional(body, &created)
+	if jsonErr != nil {
+		return created, jsonErr
+	}
+	return created, nil
+}
+
+// Update will update a shipment of an order, such as its tracking number.
+func (s *OrderShipmentServiceOp) Update(orderID int, shipment OrderShipment) (OrderShipment, error) {
+	return s.UpdateWithContext(context.Background(), orderID, shipment)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *OrderShipmentServiceOp) UpdateWithContext(ctx context.Context, orderID int, shipment OrderShipment) (OrderShipment, error) {
+	updated := OrderShipment{}
+	jsonBody, err := json.Marshal(shipment)
+	if err != nil {
+		return updated, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v2/orders/%d/shipments/%d", orderID, shipment.ID), reqBody)
+	if reqErr != nil {
+		return updated, reqErr
+	}
+
+	jsonErr := unmarshalOptional(body, &updated)
+	if jsonErr != nil {
+		return updated, jsonErr
+	}
+	return updated, nil
+}
+
+// Delete will delete a shipment of an order.
+func (s *OrderShipmentServiceOp) Delete(orderID, shipmentID int) error {
+	return s.DeleteWithContext(context.Background(), orderID, shipmentID)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *OrderShipmentServiceOp) DeleteWithContext(ctx context.Context, orderID, shipmentID int) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v2/orders/%d/shipments/%d", orderID, shipmentID), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// DeleteAll will delete every shipment of an order.
+func (s *OrderShipmentServiceOp) DeleteAll(orderID int) error {
+	return s.DeleteAllWithContext(context.Background(), orderID)
+}
+
+// DeleteAllWithContext is the context-aware variant of DeleteAll.
+func (s *OrderShipmentServiceOp) DeleteAllWithContext(ctx context.Context, orderID int) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v2/orders/%d/shipments", orderID), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
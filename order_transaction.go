@@ -0,0 +1,103 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type OrderTransactionService interface {
+	List(int, ...ListOptions) (ListTransactionResponse, error)
+	ListWithContext(context.Context, int, ...ListOptions) (ListTransactionResponse, error)
+}
+
+type ListTransactionResponse struct {
+	Data []Transaction `json:"data"`
+	Meta MetaResult    `json:"meta"`
+}
+
+// Transaction structure.
+type Transaction struct {
+	ID                     int                 `json:"id"`
+	OrderID                string              `json:"order_id"`
+	Event                  string              `json:"event"`
+	Method                 string              `json:"method"`
+	Amount                 float64             `json:"amount"`
+	Currency               string              `json:"currency"`
+	Gateway                string              `json:"gateway"`
+	GatewayTransactionID   string              `json:"gateway_transaction_id"`
+	PaymentMethodID        string              `json:"payment_method_id"`
+	DateCreated            string              `json:"date_created"`
+	Test                   bool                `json:"test"`
+	Status                 string              `json:"status"`
+	FraudReview            bool                `json:"fraud_review"`
+	ReferenceTransactionID int                 `json:"reference_transaction_id"`
+	Offline                *TransactionOffline `json:"offline,omitempty"`
+	Custom                 *TransactionCustom  `json:"custom,omitempty"`
+	CreditCard             *TransactionCard    `json:"credit_card,omitempty"`
+	AVSResult              *TransactionResult  `json:"avs_result,omitempty"`
+	CVVResult              *TransactionResult  `json:"cvv_result,omitempty"`
+}
+
+// TransactionOffline describes an offline payment.
+type TransactionOffline struct {
+	DisplayName string `json:"display_name"`
+}
+
+// TransactionCustom describes a payment taken through a custom payment method.
+type TransactionCustom struct {
+	PaymentMethod string `json:"payment_method"`
+}
+
+// TransactionCard describes the card used for a payment.
+type TransactionCard struct {
+	CardType        string `json:"card_type"`
+	CardIIN         string `json:"card_iin"`
+	CardLast4       string `json:"card_last4"`
+	CardExpiryMonth int    `json:"card_expiry_month"`
+	CardExpiryYear  int    `json:"card_expiry_year"`
+}
+
+// TransactionResult is the result of an address or card verification check.
+type TransactionResult struct {
+	Code        string `json:"code"`
+	Message     string `json:"message"`
+	StreetMatch string `json:"street_match,omitempty"`
+	PostalMatch string `json:"postal_match,omitempty"`
+}
+
+type OrderTransactionServiceOp struct {
+	client *Client
+}
+
+// List will return the payment transactions of an order.
+func (s *OrderTransactionServiceOp) List(orderID int, options ...ListOptions) (ListTransactionResponse, error) {
+	return s.ListWithContext(context.Background(), orderID, options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *OrderTransactionServiceOp) ListWithContext(ctx context.Context, orderID int, options ...ListOptions) (ListTransactionResponse, error) {
+	transactionResponse := ListTransactionResponse{}
+
+	var listOptions ListOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/orders/%d/transactions", orderID), listOptions)
+	if err != nil {
+		return transactionResponse, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return transactionResponse, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &transactionResponse)
+	if jsonErr != nil {
+		return transactionResponse, jsonErr
+	}
+	return transactionResponse, nil
+}
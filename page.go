@@ -0,0 +1,289 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Page types, as used by Page.Type.
+const (
+	PageTypePage        = "page"
+	PageTypeRaw         = "raw"
+	PageTypeContactForm = "contact_form"
+	PageTypeFeed        = "feed"
+	PageTypeLink        = "link"
+	PageTypeBlog        = "blog"
+)
+
+// PageIncludeBody returns the body of each page, which List omits by default.
+const PageIncludeBody = "body"
+
+type PageService interface {
+	Get(int, ...GetPageOptions) (Page, error)
+	GetWithContext(context.Context, int, ...GetPageOptions) (Page, error)
+	List(...ListPagesOptions) (ListPageResponse, error)
+	ListWithContext(context.Context, ...ListPagesOptions) (ListPageResponse, error)
+	Create([]Page) ([]Page, error)
+	CreateWithContext(context.Context, []Page) ([]Page, error)
+	Update(Page) (Page, error)
+	UpdateWithContext(context.Context, Page) (Page, error)
+	UpdateBatch([]Page) ([]Page, error)
+	UpdateBatchWithContext(context.Context, []Page) ([]Page, error)
+	Delete(int) error
+	DeleteWithContext(context.Context, int) error
+	DeleteMany([]int) error
+	DeleteManyWithContext(context.Context, []int) error
+	Iterate(context.Context, IteratorOptions, ...ListPagesOptions) *Iterator
+}
+
+type GetPageResponse struct {
+	Data Page `json:"data"`
+}
+
+type ListPageResponse struct {
+	Data []Page     `json:"data"`
+	Meta MetaResult `json:"meta"`
+}
+
+// Page is a web page of a channel's storefront.
+type Page struct {
+	ID              int      `json:"id,omitempty"`
+	ChannelID       int      `json:"channel_id,omitempty"`
+	Name            string   `json:"name,omitempty"`
+	Type            string   `json:"type,omitempty"`
+	Body            string   `json:"body,omitempty"`
+	URL             string   `json:"url,omitempty"`
+	ParentID        int      `json:"parent_id,omitempty"`
+	SortOrder       int      `json:"sort_order,omitempty"`
+	IsVisible       *bool    `json:"is_visible,omitempty"`
+	IsHomepage      *bool    `json:"is_homepage,omitempty"`
+	IsCustomersOnly *bool    `json:"is_customers_only,omitempty"`
+	MetaTitle       string   `json:"meta_title,omitempty"`
+	MetaDescription string   `json:"meta_description,omitempty"`
+	MetaKeywords    []string `json:"meta_keywords,omitempty"`
+	SearchKeywords  string   `json:"search_keywords,omitempty"`
+	Email           string   `json:"email,omitempty"`
+	ContactFields   string   `json:"contact_fields,omitempty"`
+	Feed            string   `json:"feed,omitempty"`
+	Link            string   `json:"link,omitempty"`
+}
+
+// GetPageOptions controls the fields returned with a page.
+type GetPageOptions struct {
+	Include []string `url:"include,omitempty"`
+}
+
+// ListPagesOptions filters the pages returned by List.
+type ListPagesOptions struct {
+	ListOptions
+	GetPageOptions
+	ChannelID int    `url:"channel_id,omitempty"`
+	IDIn      []int  `url:"id:in,omitempty"`
+	Name      string `url:"name,omitempty"`
+	NameLike  string `url:"name:like,omitempty"`
+	Type      string `url:"type,omitempty"`
+	IsVisible *bool  `url:"is_visible,omitempty"`
+}
+
+type PageServiceOp struct {
+	client *Client
+}
+
+// Get will fetch a single page by the provided ID.
+func (s *PageServiceOp) Get(id int, options ...GetPageOptions) (Page, error) {
+	return s.GetWithContext(context.Background(), id, options...)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *PageServiceOp) GetWithContext(ctx context.Context, id int, options ...GetPageOptions) (Page, error) {
+	pageResponse := GetPageResponse{}
+
+	var getOptions GetPageOptions
+	if len(options) > 0 {
+		getOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/content/pages/%d", id), getOptions)
+	if err != nil {
+		return pageResponse.Data, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return pageResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &pageResponse)
+	if jsonErr != nil {
+		return pageResponse.Data, jsonErr
+	}
+	return pageResponse.Data, nil
+}
+
+// List will return a page of web pages matching the options.
+func (s *PageServiceOp) List(options ...ListPagesOptions) (ListPageResponse, error) {
+	return s.ListWithContext(context.Background(), options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *PageServiceOp) ListWithContext(ctx context.Context, options ...ListPagesOptions) (ListPageResponse, error) {
+	listResult := ListPageResponse{}
+
+	var listOptions ListPagesOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/content/pages", listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// Create will create the provided pages.
+// The fields required on a page are: Name, Type and, for most types, Body.
+func (s *PageServiceOp) Create(pages []Page) ([]Page, error) {
+	return s.CreateWithContext(context.Background(), pages)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *PageServiceOp) CreateWithContext(ctx context.Context, pages []Page) ([]Page, error) {
+	listResult := ListPageResponse{}
+	jsonBody, err := json.Marshal(pages)
+	if err != nil {
+		return listResult.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, "/v3/content/pages", reqBody)
+	if reqErr != nil {
+		return listResult.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult.Data, jsonErr
+	}
+	return listResult.Data, nil
+}
+
+// Update will update an existing page.
+func (s *PageServiceOp) Update(page Page) (Page, error) {
+	return s.UpdateWithContext(context.Background(), page)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *PageServiceOp) UpdateWithContext(ctx context.Context, page Page) (Page, error) {
+	pageResponse := GetPageResponse{}
+	jsonBody, err := json.Marshal(page)
+	if err != nil {
+		return pageResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/content/pages/%d", page.ID), reqBody)
+	if reqErr != nil {
+		return pageResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &pageResponse)
+	if jsonErr != nil {
+		return pageResponse.Data, jsonErr
+	}
+	return pageResponse.Data, nil
+}
+
+// UpdateBatch will update several pages in a single request.
+// Every page must have its ID set.
+func (s *PageServiceOp) UpdateBatch(pages []Page) ([]Page, error) {
+	return s.UpdateBatchWithContext(context.Background(), pages)
+}
+
+// UpdateBatchWithContext is the context-aware variant of UpdateBatch.
+func (s *PageServiceOp) UpdateBatchWithContext(ctx context.Context, pages []Page) ([]Page, error) {
+	listResult := ListPageResponse{}
+	jsonBody, err := json.Marshal(pages)
+	if err != nil {
+		return listResult.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, "/v3/content/pages", reqBody)
+	if reqErr != nil {
+		return listResult.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult.Data, jsonErr
+	}
+	return listResult.Data, nil
+}
+
+// Delete will delete a page by the provided ID.
+func (s *PageServiceOp) Delete(id int) error {
+	return s.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *PageServiceOp) DeleteWithContext(ctx context.Context, id int) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/content/pages/%d", id), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// DeleteMany will delete every page with one of the provided IDs.
+func (s *PageServiceOp) DeleteMany(ids []int) error {
+	return s.DeleteManyWithContext(context.Background(), ids)
+}
+
+// DeleteManyWithContext is the context-aware variant of DeleteMany.
+func (s *PageServiceOp) DeleteManyWithContext(ctx context.Context, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	path, err := addQuery("/v3/content/pages", ListPagesOptions{IDIn: ids})
+	if err != nil {
+		return err
+	}
+
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, path, nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// Iterate will walk every page matching the options, fetching further pages as needed.
+func (s *PageServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, options ...ListPagesOptions) *Iterator {
+	var listOptions ListPagesOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListWithContext(ctx, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
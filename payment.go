@@ -0,0 +1,306 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Payment instrument types, as used by PaymentInstrument.Type.
+const (
+	PaymentInstrumentCard                = "card"
+	PaymentInstrumentStoredCard          = "stored_card"
+	PaymentInstrumentStoredPayPalAccount = "stored_paypal_account"
+	PaymentInstrumentStoredBankAccount   = "stored_bank_account"
+)
+
+// PaymentService takes payments for orders. Payments are sent to the
+// payments host, authenticated with a payment access token for the order.
+type PaymentService interface {
+	CreateAccessToken(int) (string, error)
+	CreateAccessTokenWithContext(context.Context, int) (string, error)
+	ListMethods(int) ([]PaymentMethod, error)
+	ListMethodsWithContext(context.Context, int) ([]PaymentMethod, error)
+	ListStoredInstruments(int) ([]StoredInstrument, error)
+	ListStoredInstrumentsWithContext(context.Context, int) ([]StoredInstrument, error)
+	Process(string, Payment) (PaymentResult, error)
+	ProcessWithContext(context.Context, string, Payment) (PaymentResult, error)
+	Pay(int, Payment) (PaymentResult, error)
+	PayWithContext(context.Context, int, Payment) (PaymentResult, error)
+}
+
+type ListPaymentMethodResponse struct {
+	Data []PaymentMethod `json:"data"`
+	Meta MetaResult      `json:"meta"`
+}
+
+type ListStoredInstrumentResponse struct {
+	Data []StoredInstrument `json:"data"`
+}
+
+// PaymentMethod is a payment method accepted for an order.
+type PaymentMethod struct {
+	ID                   string                `json:"id"`
+	Name                 string                `json:"name"`
+	Type                 string                `json:"type"`
+	TestMode             bool                  `json:"test_mode"`
+	SupportedInstruments []SupportedInstrument `json:"supported_instruments"`
+	StoredInstruments    []StoredInstrument    `json:"stored_instruments"`
+}
+
+// SupportedInstrument is a kind of instrument a payment method accepts.
+type SupportedInstrument struct {
+	InstrumentType            string `json:"instrument_type"`
+	VerificationValueRequired bool   `json:"verification_value_required"`
+}
+
+// StoredInstrument is a card, PayPal or bank account saved by a customer.
+type StoredInstrument struct {
+	Type                       string `json:"type"`
+	Token                      string `json:"token"`
+	IsDefault                  bool   `json:"is_default"`
+	Brand                      string `json:"brand,omitempty"`
+	ExpiryMonth                int    `json:"expiry_month,omitempty"`
+	ExpiryYear                 int    `json:"expiry_year,omitempty"`
+	IssuerIdentificationNumber string `json:"issuer_identification_number,omitempty"`
+	LastFour                   string `json:"last_4,omitempty"`
+	Email                      string `json:"email,omitempty"`
+	MaskedAccountNumber        string `json:"masked_account_number,omitempty"`
+	Issuer                     string `json:"issuer,omitempty"`
+}
+
+// Payment is a payment for an order.
+type Payment struct {
+	Instrument      PaymentInstrument `json:"instrument"`
+	PaymentMethodID string            `json:"payment_method_id"`
+	SaveInstrument  bool              `json:"save_instrument,omitempty"`
+}
+
+// PaymentInstrument is the card, or stored instrument Token, to charge.
+type PaymentInstrument struct {
+	Type              string `json:"type"`
+	Number            string `json:"number,omitempty"`
+	CardholderName    string `json:"cardholder_name,omitempty"`
+	ExpiryMonth       int    `json:"expiry_month,omitempty"`
+	ExpiryYear        int    `json:"expiry_year,omitempty"`
+	VerificationValue string `json:"verification_value,omitempty"`
+	Token             string `json:"token,omitempty"`
+}
+
+// PaymentResult is the outcome of a payment.
+type PaymentResult struct {
+	ID              string `json:"id"`
+	TransactionType string `json:"transaction_type"`
+	Status          string `json:"status"`
+}
+
+// PaymentError is returned when the payments host rejects a payment, for
+// example because the card was declined. Err holds the underlying *APIError.
+type PaymentError struct {
+	StatusCode int
+	Title      string
+	Type       string
+	Errors     []PaymentErrorDetail
+	Err        error
+}
+
+// PaymentErrorDetail is a reason a payment was rejected.
+type PaymentErrorDetail struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *PaymentError) Error() string {
+	msg := fmt.Sprintf("bigcommerce: payment failed: %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	if e.Title != "" {
+		msg += ": " + e.Title
+	}
+	if len(e.Errors) > 0 {
+		details := make([]string, 0, len(e.Errors))
+		for _, detail := range e.Errors {
+			details = append(details, fmt.Sprintf("%s: %s", detail.Code, detail.Message))
+		}
+		msg += " [" + strings.Join(details, "; ") + "]"
+	}
+	return msg
+}
+
+func (e *PaymentError) Unwrap() error {
+	return e.Err
+}
+
+// HasCode reports whether the payment was rejected with the given error code,
+// such as "card_declined".
+func (e *PaymentError) HasCode(code string) bool {
+	for _, detail := range e.Errors {
+		if detail.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// newPaymentError turns an *APIError from the payments host into a
+// *PaymentError and returns other errors unchanged.
+func newPaymentError(err error) error {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+
+	var envelope struct {
+		Title  string               `json:"title"`
+		Type   string               `json:"type"`
+		Errors []PaymentErrorDetail `json:"errors"`
+	}
+	if json.Unmarshal(apiErr.Body, &envelope) != nil {
+		envelope.Title = apiErr.Title
+	}
+
+	return &PaymentError{
+		StatusCode: apiErr.StatusCode,
+		Title:      envelope.Title,
+		Type:       envelope.Type,
+		Errors:     envelope.Errors,
+		Err:        err,
+	}
+}
+
+type paymentAccessTokenOrder struct {
+	ID int `json:"id"`
+}
+
+type paymentAccessTokenRequest struct {
+	Order paymentAccessTokenOrder `json:"order"`
+}
+
+type paymentAccessTokenResponse struct {
+	Data struct {
+		ID string `json:"id"`
+	} `json:"data"`
+}
+
+type paymentRequest struct {
+	Payment Payment `json:"payment"`
+}
+
+type paymentResultResponse struct {
+	Data PaymentResult `json:"data"`
+}
+
+type PaymentServiceOp struct {
+	client *Client
+}
+
+// CreateAccessToken will create the payment access token needed to pay for an order.
+func (s *PaymentServiceOp) CreateAccessToken(orderID int) (string, error) {
+	return s.CreateAccessTokenWithContext(context.Background(), orderID)
+}
+
+// CreateAccessTokenWithContext is the context-aware variant of CreateAccessToken.
+func (s *PaymentServiceOp) CreateAccessTokenWithContext(ctx context.Context, orderID int) (string, error) {
+	tokenResponse := paymentAccessTokenResponse{}
+	jsonBody, err := json.Marshal(paymentAccessTokenRequest{Order: paymentAccessTokenOrder{ID: orderID}})
+	if err != nil {
+		return "", err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, "/v3/payments/access_tokens", reqBody)
+	if reqErr != nil {
+		return "", reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &tokenResponse)
+	if jsonErr != nil {
+		return "", jsonErr
+	}
+	return tokenResponse.Data.ID, nil
+}
+
+// ListMethods will return the payment methods, and the stored instruments of the customer, accepted for an order.
+func (s *PaymentServiceOp) ListMethods(orderID int) ([]PaymentMethod, error) {
+	return s.ListMethodsWithContext(context.Background(), orderID)
+}
+
+// ListMethodsWithContext is the context-aware variant of ListMethods.
+func (s *PaymentServiceOp) ListMethodsWithContext(ctx context.Context, orderID int) ([]PaymentMethod, error) {
+	listResult := ListPaymentMethodResponse{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/payments/methods?order_id=%d", orderID), nil)
+	if reqErr != nil {
+		return listResult.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult.Data, jsonErr
+	}
+	return listResult.Data, nil
+}
+
+// ListStoredInstruments will return the payment instruments a customer has saved.
+func (s *PaymentServiceOp) ListStoredInstruments(customerID int) ([]StoredInstrument, error) {
+	return s.ListStoredInstrumentsWithContext(context.Background(), customerID)
+}
+
+// ListStoredInstrumentsWithContext is the context-aware variant of ListStoredInstruments.
+func (s *PaymentServiceOp) ListStoredInstrumentsWithContext(ctx context.Context, customerID int) ([]StoredInstrument, error) {
+	listResult := ListStoredInstrumentResponse{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/customers/%d/stored-instruments", customerID), nil)
+	if reqErr != nil {
+		return listResult.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult.Data, jsonErr
+	}
+	return listResult.Data, nil
+}
+
+// Process will take a payment for an order using a payment access token.
+// Payments that fail are returned as a *PaymentError.
+func (s *PaymentServiceOp) Process(accessToken string, payment Payment) (PaymentResult, error) {
+	return s.ProcessWithContext(context.Background(), accessToken, payment)
+}
+
+// ProcessWithContext is the context-aware variant of Process.
+func (s *PaymentServiceOp) ProcessWithContext(ctx context.Context, accessToken string, payment Payment) (PaymentResult, error) {
+	paymentResponse := paymentResultResponse{}
+
+	jsonBody, err := json.Marshal(paymentRequest{Payment: payment})
+	if err != nil {
+		return paymentResponse.Data, err
+	}
+	header := http.Header{
+		"Authorization": {"PAT " + accessToken},
+		"Accept":        {"application/vnd.bc.v1+json"},
+	}
+	body, reqErr := s.client.doRequest(ctx, http.MethodPost, s.client.paymentsURL(), header, bytes.NewReader(jsonBody))
+	if reqErr != nil {
+		return paymentResponse.Data, newPaymentError(reqErr)
+	}
+
+	jsonErr := json.Unmarshal(body, &paymentResponse)
+	if jsonErr != nil {
+		return paymentResponse.Data, jsonErr
+	}
+	return paymentResponse.Data, nil
+}
+
+// Pay will create a payment access token for an order and use it to take the payment.
+func (s *PaymentServiceOp) Pay(orderID int, payment Payment) (PaymentResult, error) {
+	return s.PayWithContext(context.Background(), orderID, payment)
+}
+
+// PayWithContext is the context-aware variant of Pay.
+func (s *PaymentServiceOp) PayWithContext(ctx context.Context, orderID int, payment Payment) (PaymentResult, error) {
+	accessToken, err := s.CreateAccessTokenWithContext(ctx, orderID)
+	if err != nil {
+		return PaymentResult{}, err
+	}
+	return s.ProcessWithContext(ctx, accessToken, payment)
+}
@@ -0,0 +1,63 @@
+package bigcommerce
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestPaymentProcessUsesPaymentsHost(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v3/payments/access_tokens":
+			if r.Header.Get("X-Auth-Token") != "token" {
+				t.Errorf("access token request X-Auth-Token = %q", r.Header.Get("X-Auth-Token"))
+			}
+			w.Write([]byte(`{"data":{"id":"pat-123"}}`))
+		case "/stores/store/payments":
+			if got := r.Header.Get("Authorization"); got != "PAT pat-123" {
+				t.Errorf("Authorization = %q, want the payment access token", got)
+			}
+			if r.Header.Get("X-Auth-Client") != "" || r.Header.Get("X-Auth-Token") != "" {
+				t.Errorf("payment request sent store credentials: %v", r.Header)
+			}
+			if got := r.Header.Get("Accept"); got != "application/vnd.bc.v1+json" {
+				t.Errorf("Accept = %q", got)
+			}
+			w.Write([]byte(`{"data":{"id":"txn-1","transaction_type":"purchase","status":"success"}}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	WithPaymentsHost(client.baseURL)(client)
+
+	result, err := client.Payments.Pay(42, Payment{PaymentMethodID: "stripe.card", Instrument: PaymentInstrument{Type: "stored_card", Token: "tok"}})
+	if err != nil {
+		t.Fatalf("Pay: %v", err)
+	}
+	if result.ID != "txn-1" || result.Status != "success" {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+func TestPaymentProcessReturnsPaymentError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"title":"Payment failed","type":"https://developer.bigcommerce.com/payments","errors":[{"code":"card_declined","message":"Your card was declined."}]}`))
+	})
+	WithPaymentsHost(client.baseURL)(client)
+
+	_, err := client.Payments.Process("pat-123", Payment{PaymentMethodID: "stripe.card"})
+
+	var paymentErr *PaymentError
+	if !errors.As(err, &paymentErr) {
+		t.Fatalf("err = %v, want a *PaymentError", err)
+	}
+	if paymentErr.StatusCode != http.StatusUnprocessableEntity || paymentErr.Title != "Payment failed" || !paymentErr.HasCode("card_declined") {
+		t.Errorf("PaymentError = %+v", paymentErr)
+	}
+	if !IsValidationError(err) {
+		t.Error("PaymentError does not unwrap to the APIError")
+	}
+}
@@ -0,0 +1,198 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type PlacementService interface {
+	Get(string) (Placement, error)
+	GetWithContext(context.Context, string) (Placement, error)
+	List(...ListPlacementsOptions) (ListPlacementResponse, error)
+	ListWithContext(context.Context, ...ListPlacementsOptions) (ListPlacementResponse, error)
+	Create(Placement) (Placement, error)
+	CreateWithContext(context.Context, Placement) (Placement, error)
+	Update(Placement) (Placement, error)
+	UpdateWithContext(context.Context, Placement) (Placement, error)
+	Delete(string) error
+	DeleteWithContext(context.Context, string) error
+	Iterate(context.Context, IteratorOptions, ...ListPlacementsOptions) *Iterator
+}
+
+type GetPlacementResponse struct {
+	Data Placement `json:"data"`
+}
+
+type ListPlacementResponse struct {
+	Data []Placement `json:"data"`
+	Meta MetaResult  `json:"meta"`
+}
+
+// Placement structure.
+// When creating or updating a placement, set WidgetUUID; the API returns the
+// placed widget in Widget.
+type Placement struct {
+	UUID         string  `json:"uuid,omitempty"`
+	TemplateFile string  `json:"template_file,omitempty"`
+	WidgetUUID   string  `json:"widget_uuid,omitempty"`
+	Widget       *Widget `json:"widget,omitempty"`
+	EntityID     string  `json:"entity_id,omitempty"`
+	SortOrder    int     `json:"sort_order"`
+	Region       string  `json:"region,omitempty"`
+	Status       string  `json:"status,omitempty"`
+	ChannelID    int     `json:"channel_id,omitempty"`
+	DateCreated  string  `json:"date_created,omitempty"`
+	DateModified string  `json:"date_modified,omitempty"`
+}
+
+// ListPlacementsOptions filters the placements returned by List.
+type ListPlacementsOptions struct {
+	ListOptions
+	WidgetTemplateKind string `url:"widget_template_kind,omitempty"`
+	TemplateFile       string `url:"template_file,omitempty"`
+	WidgetUUID         string `url:"widget_uuid,omitempty"`
+	ChannelID          int    `url:"channel_id,omitempty"`
+}
+
+type PlacementServiceOp struct {
+	client *Client
+}
+
+// Get will retrieve a placement by the UUID
+func (s *PlacementServiceOp) Get(uuid string) (Placement, error) {
+	return s.GetWithContext(context.Background(), uuid)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *PlacementServiceOp) GetWithContext(ctx context.Context, uuid string) (Placement, error) {
+	placementResponse := GetPlacementResponse{}
+	body, err := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/content/placements/%s", uuid), nil)
+	if err != nil {
+		return placementResponse.Data, err
+	}
+
+	jsonErr := json.Unmarshal(body, &placementResponse)
+	if jsonErr != nil {
+		return placementResponse.Data, jsonErr
+	}
+
+	return placementResponse.Data, nil
+}
+
+// List will return a page of placements matching the options.
+func (s *PlacementServiceOp) List(options ...ListPlacementsOptions) (ListPlacementResponse, error) {
+	return s.ListWithContext(context.Background(), options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *PlacementServiceOp) ListWithContext(ctx context.Context, options ...ListPlacementsOptions) (ListPlacementResponse, error) {
+	listResult := ListPlacementResponse{}
+
+	var listOptions ListPlacementsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/content/placements", listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, err := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return listResult, err
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// Create will place a widget in a region of a template file.
+func (s *PlacementServiceOp) Create(placement Placement) (Placement, error) {
+	return s.CreateWithContext(context.Background(), placement)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *PlacementServiceOp) CreateWithContext(ctx context.Context, placement Placement) (Placement, error) {
+	placementResponse := GetPlacementResponse{}
+	jsonBody, err := json.Marshal(placement)
+	if err != nil {
+		return placementResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, "/v3/content/placements", reqBody)
+	if reqErr != nil {
+		return placementResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &placementResponse)
+	if jsonErr != nil {
+		return placementResponse.Data, jsonErr
+	}
+	return placementResponse.Data, nil
+}
+
+// Update will update a single placement.
+func (s *PlacementServiceOp) Update(placement Placement) (Placement, error) {
+	return s.UpdateWithContext(context.Background(), placement)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *PlacementServiceOp) UpdateWithContext(ctx context.Context, placement Placement) (Placement, error) {
+	placementResponse := GetPlacementResponse{}
+	jsonBody, err := json.Marshal(placement)
+	if err != nil {
+		return placementResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/content/placements/%s", placement.UUID), reqBody)
+	if reqErr != nil {
+		return placementResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &placementResponse)
+	if jsonErr != nil {
+		return placementResponse.Data, jsonErr
+	}
+	return placementResponse.Data, nil
+}
+
+// Delete will delete a placement by the provided UUID.
+func (s *PlacementServiceOp) Delete(uuid string) error {
+	return s.DeleteWithContext(context.Background(), uuid)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *PlacementServiceOp) DeleteWithContext(ctx context.Context, uuid string) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/content/placements/%s", uuid), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// Iterate will walk every placement matching the options, fetching further pages as needed.
+func (s *PlacementServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, options ...ListPlacementsOptions) *Iterator {
+	var listOptions ListPlacementsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListWithContext(ctx, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
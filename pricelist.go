@@ -0,0 +1,341 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// PriceListsService groups the price list services.
+type PriceListsService struct {
+	PriceLists PriceListService
+	Records    PriceListRecordService
+}
+
+type PriceListService interface {
+	Get(int) (PriceList, error)
+	GetWithContext(context.Context, int) (PriceList, error)
+	List(...ListPriceListsOptions) (ListPriceListResponse, error)
+	ListWithContext(context.Context, ...ListPriceListsOptions) (ListPriceListResponse, error)
+	Create(PriceList) (PriceList, error)
+	CreateWithContext(context.Context, PriceList) (PriceList, error)
+	Update(PriceList) (PriceList, error)
+	UpdateWithContext(context.Context, PriceList) (PriceList, error)
+	Delete(int) error
+	DeleteWithContext(context.Context, int) error
+	Iterate(context.Context, IteratorOptions, ...ListPriceListsOptions) *Iterator
+	ListAssignments(...ListPriceListAssignmentsOptions) (ListPriceListAssignmentResponse, error)
+	ListAssignmentsWithContext(context.Context, ...ListPriceListAssignmentsOptions) (ListPriceListAssignmentResponse, error)
+	CreateAssignments([]PriceListAssignment) error
+	CreateAssignmentsWithContext(context.Context, []PriceListAssignment) error
+	UpsertAssignment(int, PriceListAssignment) (PriceListAssignment, error)
+	UpsertAssignmentWithContext(context.Context, int, PriceListAssignment) (PriceListAssignment, error)
+	DeleteAssignments([]int) error
+	DeleteAssignmentsWithContext(context.Context, []int) error
+}
+
+type GetPriceListResponse struct {
+	Data PriceList `json:"data"`
+}
+
+type ListPriceListResponse struct {
+	Data []PriceList `json:"data"`
+	Meta MetaResult  `json:"meta"`
+}
+
+type GetPriceListAssignmentResponse struct {
+	Data PriceListAssignment `json:"data"`
+}
+
+type ListPriceListAssignmentResponse struct {
+	Data []PriceListAssignment `json:"data"`
+	Meta MetaResult            `json:"meta"`
+}
+
+// PriceList structure.
+type PriceList struct {
+	ID           int    `json:"id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Active       *bool  `json:"active,omitempty"`
+	DateCreated  string `json:"date_created,omitempty"`
+	DateModified string `json:"date_modified,omitempty"`
+}
+
+// PriceListAssignment makes a price list apply to a customer group, a channel,
+// or a customer group on a channel.
+type PriceListAssignment struct {
+	ID              int `json:"id,omitempty"`
+	PriceListID     int `json:"price_list_id,omitempty"`
+	CustomerGroupID int `json:"customer_group_id,omitempty"`
+	ChannelID       int `json:"channel_id,omitempty"`
+}
+
+// ListPriceListsOptions filters the price lists returned by List.
+type ListPriceListsOptions struct {
+	ListOptions
+	ID              int       `url:"id,omitempty"`
+	IDIn            []int     `url:"id:in,omitempty"`
+	Name            string    `url:"name,omitempty"`
+	NameLike        string    `url:"name:like,omitempty"`
+	DateCreated     time.Time `url:"date_created,omitempty"`
+	DateCreatedMin  time.Time `url:"date_created:min,omitempty"`
+	DateCreatedMax  time.Time `url:"date_created:max,omitempty"`
+	DateModified    time.Time `url:"date_modified,omitempty"`
+	DateModifiedMin time.Time `url:"date_modified:min,omitempty"`
+	DateModifiedMax time.Time `url:"date_modified:max,omitempty"`
+}
+
+// ListPriceListAssignmentsOptions filters the assignments returned by ListAssignments.
+type ListPriceListAssignmentsOptions struct {
+	ListOptions
+	IDIn              []int `url:"id:in,omitempty"`
+	PriceListID       int   `url:"price_list_id,omitempty"`
+	PriceListIDIn     []int `url:"price_list_id:in,omitempty"`
+	CustomerGroupID   int   `url:"customer_group_id,omitempty"`
+	CustomerGroupIDIn []int `url:"customer_group_id:in,omitempty"`
+	ChannelID         int   `url:"channel_id,omitempty"`
+	ChannelIDIn       []int `url:"channel_id:in,omitempty"`
+}
+
+type PriceListServiceOp struct {
+	client *Client
+}
+
+// Get will fetch a single price list by the provided ID.
+func (s *PriceListServiceOp) Get(id int) (PriceList, error) {
+	return s.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *PriceListServiceOp) GetWithContext(ctx context.Context, id int) (PriceList, error) {
+	priceListResponse := GetPriceListResponse{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/pricelists/%d", id), nil)
+	if reqErr != nil {
+		return priceListResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &priceListResponse)
+	if jsonErr != nil {
+		return priceListResponse.Data, jsonErr
+	}
+	return priceListResponse.Data, nil
+}
+
+// List will return a page of price lists matching the options.
+func (s *PriceListServiceOp) List(options ...ListPriceListsOptions) (ListPriceListResponse, error) {
+	return s.ListWithContext(context.Background(), options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *PriceListServiceOp) ListWithContext(ctx context.Context, options ...ListPriceListsOptions) (ListPriceListResponse, error) {
+	listResult := ListPriceListResponse{}
+
+	var listOptions ListPriceListsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/pricelists", listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// Create will create a new price list.
+// The only field required on a price list is: Name
+func (s *PriceListServiceOp) Create(priceList PriceList) (PriceList, error) {
+	return s.CreateWithContext(context.Background(), priceList)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *PriceListServiceOp) CreateWithContext(ctx context.Context, priceList PriceList) (PriceList, error) {
+	priceListResponse := GetPriceListResponse{}
+	jsonBody, err := json.Marshal(priceList)
+	if err != nil {
+		return priceListResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, "/v3/pricelists", reqBody)
+	if reqErr != nil {
+		return priceListResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &priceListResponse)
+	if jsonErr != nil {
+		return priceListResponse.Data, jsonErr
+	}
+	return priceListResponse.Data, nil
+}
+
+// Update will update an existing price list.
+func (s *PriceListServiceOp) Update(priceList PriceList) (PriceList, error) {
+	return s.UpdateWithContext(context.Background(), priceList)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *PriceListServiceOp) UpdateWithContext(ctx context.Context, priceList PriceList) (PriceList, error) {
+	priceListResponse := GetPriceListResponse{}
+	jsonBody, err := json.Marshal(priceList)
+	if err != nil {
+		return priceListResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/pricelists/%d", priceList.ID), reqBody)
+	if reqErr != nil {
+		return priceListResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &priceListResponse)
+	if jsonErr != nil {
+		return priceListResponse.Data, jsonErr
+	}
+	return priceListResponse.Data, nil
+}
+
+// Delete will delete a price list, and its records, by the provided ID.
+func (s *PriceListServiceOp) Delete(id int) error {
+	return s.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *PriceListServiceOp) DeleteWithContext(ctx context.Context, id int) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/pricelists/%d", id), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// Iterate will walk every price list matching the options, fetching further pages as needed.
+func (s *PriceListServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, options ...ListPriceListsOptions) *Iterator {
+	var listOptions ListPriceListsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListWithContext(ctx, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
+
+// ListAssignments will return a page of price list assignments matching the options.
+func (s *PriceListServiceOp) ListAssignments(options ...ListPriceListAssignmentsOptions) (ListPriceListAssignmentResponse, error) {
+	return s.ListAssignmentsWithContext(context.Background(), options...)
+}
+
+// ListAssignmentsWithContext is the context-aware variant of ListAssignments.
+func (s *PriceListServiceOp) ListAssignmentsWithContext(ctx context.Context, options ...ListPriceListAssignmentsOptions) (ListPriceListAssignmentResponse, error) {
+	listResult := ListPriceListAssignmentResponse{}
+
+	var listOptions ListPriceListAssignmentsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/pricelists/assignments", listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// CreateAssignments will assign price lists to customer groups and channels.
+// Only one price list can be assigned to each customer group and channel pair.
+func (s *PriceListServiceOp) CreateAssignments(assignments []PriceListAssignment) error {
+	return s.CreateAssignmentsWithContext(context.Background(), assignments)
+}
+
+// CreateAssignmentsWithContext is the context-aware variant of CreateAssignments.
+func (s *PriceListServiceOp) CreateAssignmentsWithContext(ctx context.Context, assignments []PriceListAssignment) error {
+	jsonBody, err := json.Marshal(assignments)
+	if err != nil {
+		return err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, "/v3/pricelists/assignments", reqBody)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// UpsertAssignment will assign a price list to a customer group and channel pair,
+// replacing the price list assigned to it, if any.
+func (s *PriceListServiceOp) UpsertAssignment(priceListID int, assignment PriceListAssignment) (PriceListAssignment, error) {
+	return s.UpsertAssignmentWithContext(context.Background(), priceListID, assignment)
+}
+
+// UpsertAssignmentWithContext is the context-aware variant of UpsertAssignment.
+func (s *PriceListServiceOp) UpsertAssignmentWithContext(ctx context.Context, priceListID int, assignment PriceListAssignment) (PriceListAssignment, error) {
+	assignmentResponse := GetPriceListAssignmentResponse{}
+	jsonBody, err := json.Marshal(assignment)
+	if err != nil {
+		return assignmentResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/pricelists/%d/assignments", priceListID), reqBody)
+	if reqErr != nil {
+		return assignmentResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &assignmentResponse)
+	if jsonErr != nil {
+		return assignmentResponse.Data, jsonErr
+	}
+	return assignmentResponse.Data, nil
+}
+
+// DeleteAssignments will delete every price list assignment with one of the provided IDs.
+func (s *PriceListServiceOp) DeleteAssignments(ids []int) error {
+	return s.DeleteAssignmentsWithContext(context.Background(), ids)
+}
+
+// DeleteAssignmentsWithContext is the context-aware variant of DeleteAssignments.
+func (s *PriceListServiceOp) DeleteAssignmentsWithContext(ctx context.Context, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	path, err := addQuery("/v3/pricelists/assignments", ListPriceListAssignmentsOptions{IDIn: ids})
+	if err != nil {
+		return err
+	}
+
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, path, nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
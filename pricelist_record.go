@@ -0,0 +1,389 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// MaxPriceRecordBatchSize is the number of price records the API accepts in a single batch.
+const MaxPriceRecordBatchSize = 1000
+
+// defaultBulkUpsertConcurrency is the number of batches BulkUpsert submits at once.
+const defaultBulkUpsertConcurrency = 2
+
+// Sub-resources that can be requested with ListPriceRecordsOptions.Include.
+const (
+	PriceRecordIncludeBulkPricingTiers = "bulk_pricing_tiers"
+	PriceRecordIncludeSKU              = "sku"
+)
+
+type PriceListRecordService interface {
+	Get(int, int, string) (PriceRecord, error)
+	GetWithContext(context.Context, int, int, string) (PriceRecord, error)
+	List(int, ...ListPriceRecordsOptions) (ListPriceRecordResponse, error)
+	ListWithContext(context.Context, int, ...ListPriceRecordsOptions) (ListPriceRecordResponse, error)
+	Set(int, PriceRecord) (PriceRecord, error)
+	SetWithContext(context.Context, int, PriceRecord) (PriceRecord, error)
+	Upsert(int, []PriceRecord) error
+	UpsertWithContext(context.Context, int, []PriceRecord) error
+	BulkUpsert(int, []PriceRecord, ...BulkUpsertOptions) (BulkUpsertResult, error)
+	BulkUpsertWithContext(context.Context, int, []PriceRecord, ...BulkUpsertOptions) (BulkUpsertResult, error)
+	Delete(int, int, string) error
+	DeleteWithContext(context.Context, int, int, string) error
+	DeleteMany(int, []int, ...DeletePriceRecordsOptions) error
+	DeleteManyWithContext(context.Context, int, []int, ...DeletePriceRecordsOptions) error
+	Iterate(context.Context, IteratorOptions, int, ...ListPriceRecordsOptions) *Iterator
+}
+
+type GetPriceRecordResponse struct {
+	Data PriceRecord `json:"data"`
+}
+
+type ListPriceRecordResponse struct {
+	Data []PriceRecord `json:"data"`
+	Meta MetaResult    `json:"meta"`
+}
+
+// PriceRecord is the price of a variant in a currency on a price list.
+type PriceRecord struct {
+	PriceListID      int               `json:"price_list_id,omitempty"`
+	VariantID        int               `json:"variant_id,omitempty"`
+	ProductID        int               `json:"product_id,omitempty"`
+	SKU              string            `json:"sku,omitempty"`
+	Currency         string            `json:"currency,omitempty"`
+	Price            *float64          `json:"price,omitempty"`
+	SalePrice        *float64          `json:"sale_price,omitempty"`
+	RetailPrice      *float64          `json:"retail_price,omitempty"`
+	MapPrice         *float64          `json:"map_price,omitempty"`
+	CalculatedPrice  float64           `json:"calculated_price,omitempty"`
+	BulkPricingTiers []BulkPricingTier `json:"bulk_pricing_tiers,omitempty"`
+	DateCreated      string            `json:"date_created,omitempty"`
+	DateModified     string            `json:"date_modified,omitempty"`
+}
+
+// BulkPricingTier is a quantity based price of a price record.
+type BulkPricingTier struct {
+	QuantityMin int     `json:"quantity_min"`
+	QuantityMax int     `json:"quantity_max,omitempty"`
+	Type        string  `json:"type"`
+	Amount      float64 `json:"amount"`
+}
+
+// ListPriceRecordsOptions filters the records returned by List.
+type ListPriceRecordsOptions struct {
+	ListOptions
+	VariantIDIn []int    `url:"variant_id:in,omitempty"`
+	ProductIDIn []int    `url:"product_id:in,omitempty"`
+	Currency    string   `url:"currency,omitempty"`
+	CurrencyIn  []string `url:"currency:in,omitempty"`
+	SKU         string   `url:"sku,omitempty"`
+	SKUIn       []string `url:"sku:in,omitempty"`
+	Include     []string `url:"include,omitempty"`
+}
+
+// DeletePriceRecordsOptions restricts the records deleted by DeleteMany.
+type DeletePriceRecordsOptions struct {
+	VariantIDIn []int  `url:"variant_id:in,omitempty"`
+	Currency    string `url:"currency,omitempty"`
+}
+
+// BulkUpsertOptions controls how BulkUpsert submits records.
+type BulkUpsertOptions struct {
+	// BatchSize is the number of records sent per request. It defaults to,
+	// and cannot exceed, MaxPriceRecordBatchSize.
+	BatchSize int
+	// Concurrency is the number of requests in flight at once. Requests
+	// still wait for the store's rate limit quota. Defaults to 2.
+	Concurrency int
+}
+
+// BulkUpsertResult summarises a BulkUpsert.
+type BulkUpsertResult struct {
+	// Upserted is the number of records that were saved.
+	Upserted int
+	// Failed lists the records that were not saved.
+	Failed []PriceRecordFailure
+}
+
+// PriceRecordFailure is a record that BulkUpsert could not save.
+type PriceRecordFailure struct {
+	Record PriceRecord
+	// Message is the API's reason for rejecting this record, when it gave one;
+	// records without one were rejected along with the rest of their batch.
+	Message string
+	// Err is the error of the batch the record was sent in.
+	Err error
+}
+
+// bulkUpsertPriceRecords splits records into batches and sends them with up
+// to options.Concurrency calls of upsert at a time.
+func bulkUpsertPriceRecords(ctx context.Context, records []PriceRecord, options BulkUpsertOptions, upsert func(context.Context, []PriceRecord) error) (BulkUpsertResult, error) {
+	batchSize := options.BatchSize
+	if batchSize <= 0 || batchSize > MaxPriceRecordBatchSize {
+		batchSize = MaxPriceRecordBatchSize
+	}
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultBulkUpsertConcurrency
+	}
+
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		result BulkUpsertResult
+	)
+	batches := make(chan []PriceRecord)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for batch := range batches {
+				err := ctx.Err()
+				if err == nil {
+					err = upsert(ctx, batch)
+				}
+
+				mu.Lock()
+				if err == nil {
+					result.Upserted += len(batch)
+				} else {
+					result.Failed = append(result.Failed, priceRecordFailures(batch, err)...)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for start := 0; start < len(records); start += batchSize {
+		end := start + batchSize
+		if end > len(records) {
+			end = len(records)
+		}
+		batches <- records[start:end]
+	}
+	close(batches)
+	wg.Wait()
+
+	if len(result.Failed) > 0 {
+		return result, fmt.Errorf("bigcommerce: %d of %d price records failed to upsert", len(result.Failed), len(records))
+	}
+	return result, nil
+}
+
+// priceRecordFailures lists the records of a failed batch, the whole of which
+// is rejected. Records the API reports errors against by position ("3.price")
+// get those errors as their Message.
+func priceRecordFailures(batch []PriceRecord, err error) []PriceRecordFailure {
+	messages := map[int][]string{}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		for key, message := range apiErr.Errors {
+			if index, ok := batchErrorIndex(key); ok {
+				messages[index] = append(messages[index], key+": "+message)
+			}
+		}
+	}
+
+	failures := make([]PriceRecordFailure, 0, len(batch))
+	for index, record := range batch {
+		recordMessages := messages[index]
+		sort.Strings(recordMessages)
+		failures = append(failures, PriceRecordFailure{Record: record, Message: strings.Join(recordMessages, "; "), Err: err})
+	}
+	return failures
+}
+
+type PriceListRecordServiceOp struct {
+	client *Client
+}
+
+// Get will fetch the record of a variant in a currency.
+func (s *PriceListRecordServiceOp) Get(priceListID, variantID int, currency string) (PriceRecord, error) {
+	return s.GetWithContext(context.Background(), priceListID, variantID, currency)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *PriceListRecordServiceOp) GetWithContext(ctx context.Context, priceListID, variantID int, currency string) (PriceRecord, error) {
+	recordResponse := GetPriceRecordResponse{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/pricelists/%d/records/%d/%s", priceListID, variantID, currency), nil)
+	if reqErr != nil {
+		return recordResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &recordResponse)
+	if jsonErr != nil {
+		return recordResponse.Data, jsonErr
+	}
+	return recordResponse.Data, nil
+}
+
+// List will return a page of the records of a price list matching the options.
+func (s *PriceListRecordServiceOp) List(priceListID int, options ...ListPriceRecordsOptions) (ListPriceRecordResponse, error) {
+	return s.ListWithContext(context.Background(), priceListID, options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *PriceListRecordServiceOp) ListWithContext(ctx context.Context, priceListID int, options ...ListPriceRecordsOptions) (ListPriceRecordResponse, error) {
+	listResult := ListPriceRecordResponse{}
+
+	var listOptions ListPriceRecordsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/pricelists/%d/records", priceListID), listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// Set will create or update the record of a variant in a currency.
+func (s *PriceListRecordServiceOp) Set(priceListID int, record PriceRecord) (PriceRecord, error) {
+	return s.SetWithContext(context.Background(), priceListID, record)
+}
+
+// SetWithContext is the context-aware variant of Set.
+func (s *PriceListRecordServiceOp) SetWithContext(ctx context.Context, priceListID int, record PriceRecord) (PriceRecord, error) {
+	recordResponse := GetPriceRecordResponse{}
+	jsonBody, err := json.Marshal(record)
+	if err != nil {
+		return recordResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/pricelists/%d/records/%d/%s", priceListID, record.VariantID, record.Currency), reqBody)
+	if reqErr != nil {
+		return recordResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &recordResponse)
+	if jsonErr != nil {
+		return recordResponse.Data, jsonErr
+	}
+	return recordResponse.Data, nil
+}
+
+// Upsert will create or update up to MaxPriceRecordBatchSize records in a single request.
+// Use BulkUpsert for more records.
+func (s *PriceListRecordServiceOp) Upsert(priceListID int, records []PriceRecord) error {
+	return s.UpsertWithContext(context.Background(), priceListID, records)
+}
+
+// UpsertWithContext is the context-aware variant of Upsert.
+func (s *PriceListRecordServiceOp) UpsertWithContext(ctx context.Context, priceListID int, records []PriceRecord) error {
+	if len(records) > MaxPriceRecordBatchSize {
+		return fmt.Errorf("bigcommerce: cannot upsert %d price records in one batch, the limit is %d", len(records), MaxPriceRecordBatchSize)
+	}
+
+	jsonBody, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/pricelists/%d/records", priceListID), reqBody)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// BulkUpsert will create or update any number of records, splitting them into
+// batches that are submitted concurrently. Every batch is attempted; the
+// returned result lists the records that failed, and the error is non-nil
+// when any did.
+func (s *PriceListRecordServiceOp) BulkUpsert(priceListID int, records []PriceRecord, options ...BulkUpsertOptions) (BulkUpsertResult, error) {
+	return s.BulkUpsertWithContext(context.Background(), priceListID, records, options...)
+}
+
+// BulkUpsertWithContext is the context-aware variant of BulkUpsert.
+func (s *PriceListRecordServiceOp) BulkUpsertWithContext(ctx context.Context, priceListID int, records []PriceRecord, options ...BulkUpsertOptions) (BulkUpsertResult, error) {
+	var bulkOptions BulkUpsertOptions
+	if len(options) > 0 {
+		bulkOptions = options[0]
+	}
+
+	return bulkUpsertPriceRecords(ctx, records, bulkOptions, func(ctx context.Context, batch []PriceRecord) error {
+		return s.UpsertWithContext(ctx, priceListID, batch)
+	})
+}
+
+// Delete will delete the records of a variant in a currency.
+func (s *PriceListRecordServiceOp) Delete(priceListID, variantID int, currency string) error {
+	return s.DeleteWithContext(context.Background(), priceListID, variantID, currency)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *PriceListRecordServiceOp) DeleteWithContext(ctx context.Context, priceListID, variantID int, currency string) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/pricelists/%d/records/%d/%s", priceListID, variantID, currency), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// DeleteMany will delete the records of the provided variants, in every currency
+// unless options restrict it to one.
+func (s *PriceListRecordServiceOp) DeleteMany(priceListID int, variantIDs []int, options ...DeletePriceRecordsOptions) error {
+	return s.DeleteManyWithContext(context.Background(), priceListID, variantIDs, options...)
+}
+
+// DeleteManyWithContext is the context-aware variant of DeleteMany.
+func (s *PriceListRecordServiceOp) DeleteManyWithContext(ctx context.Context, priceListID int, variantIDs []int, options ...DeletePriceRecordsOptions) error {
+	if len(variantIDs) == 0 {
+		return nil
+	}
+
+	var deleteOptions DeletePriceRecordsOptions
+	if len(options) > 0 {
+		deleteOptions = options[0]
+	}
+	deleteOptions.VariantIDIn = variantIDs
+
+	path, err := addQuery(fmt.Sprintf("/v3/pricelists/%d/records", priceListID), deleteOptions)
+	if err != nil {
+		return err
+	}
+
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, path, nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// Iterate will walk every record matching the options, fetching further pages as needed.
+func (s *PriceListRecordServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, priceListID int, options ...ListPriceRecordsOptions) *Iterator {
+	var listOptions ListPriceRecordsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListWithContext(ctx, priceListID, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
@@ -0,0 +1,139 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func priceRecords(n int) []PriceRecord {
+	records := make([]PriceRecord, n)
+	for i := range records {
+		records[i] = PriceRecord{VariantID: i + 1, Currency: "usd", Price: Float64(10)}
+	}
+	return records
+}
+
+func TestBulkUpsertPriceRecordsBatchesConcurrently(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		sizes    []int
+		inFlight int32
+		maxSeen  int32
+	)
+	upsert := func(ctx context.Context, batch []PriceRecord) error {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		mu.Lock()
+		sizes = append(sizes, len(batch))
+		if current > maxSeen {
+			maxSeen = current
+		}
+		mu.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+		return nil
+	}
+
+	result, err := bulkUpsertPriceRecords(context.Background(), priceRecords(2500), BulkUpsertOptions{Concurrency: 3}, upsert)
+	if err != nil {
+		t.Fatalf("bulkUpsertPriceRecords: %v", err)
+	}
+	if result.Upserted != 2500 || len(result.Failed) != 0 {
+		t.Errorf("result = %d upserted, %d failed", result.Upserted, len(result.Failed))
+	}
+
+	total := 0
+	for _, size := range sizes {
+		if size > MaxPriceRecordBatchSize {
+			t.Errorf("sent a batch of %d records", size)
+		}
+		total += size
+	}
+	if len(sizes) != 3 || total != 2500 {
+		t.Errorf("batch sizes = %v, want 3 batches covering 2500 records", sizes)
+	}
+	if maxSeen > 3 {
+		t.Errorf("%d batches were in flight at once, want at most 3", maxSeen)
+	}
+}
+
+func TestBulkUpsertPriceRecordsReportsFailedBatches(t *testing.T) {
+	apiErr := &APIError{StatusCode: http.StatusUnprocessableEntity, Errors: map[string]string{"1.price": "must be positive"}}
+	upsert := func(ctx context.Context, batch []PriceRecord) error {
+		if batch[0].VariantID == 3 {
+			return apiErr
+		}
+		return nil
+	}
+
+	result, err := bulkUpsertPriceRecords(context.Background(), priceRecords(5), BulkUpsertOptions{BatchSize: 2}, upsert)
+	if err == nil {
+		t.Fatal("bulkUpsertPriceRecords succeeded, want an error")
+	}
+	if result.Upserted != 3 || len(result.Failed) != 2 {
+		t.Fatalf("result = %d upserted, %d failed, want 3 and 2", result.Upserted, len(result.Failed))
+	}
+
+	failures := map[int]PriceRecordFailure{}
+	for _, failure := range result.Failed {
+		failures[failure.Record.VariantID] = failure
+	}
+	if failures[3].Message != "" || failures[4].Message != "1.price: must be positive" {
+		t.Errorf("failures = %+v", result.Failed)
+	}
+	if !errors.Is(failures[4].Err, apiErr) {
+		t.Errorf("failure Err = %v, want the batch's error", failures[4].Err)
+	}
+}
+
+func TestBulkUpsertPriceRecordsStopsSendingWhenCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var calls int32
+	result, err := bulkUpsertPriceRecords(ctx, priceRecords(3), BulkUpsertOptions{BatchSize: 1}, func(ctx context.Context, batch []PriceRecord) error {
+		atomic.AddInt32(&calls, 1)
+		return nil
+	})
+	if err == nil || len(result.Failed) != 3 || calls != 0 {
+		t.Errorf("got %d calls, %d failures and err %v, want no calls and every record failed", calls, len(result.Failed), err)
+	}
+}
+
+func TestPriceListRecordsBulkUpsert(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/v3/pricelists/7/records" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var batch []PriceRecord
+		body, _ := ioutil.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &batch); err != nil {
+			t.Errorf("decoding batch: %v", err)
+		}
+		if batch[0].VariantID > MaxPriceRecordBatchSize {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"status":422,"title":"Invalid records","errors":{"0.currency":"unknown currency"}}`))
+			return
+		}
+		w.Write([]byte(`{"data":[],"meta":{}}`))
+	})
+
+	result, err := client.PriceLists.Records.BulkUpsert(7, priceRecords(1500))
+	if err == nil {
+		t.Fatal("BulkUpsert succeeded, want an error")
+	}
+	if result.Upserted != 1000 || len(result.Failed) != 500 {
+		t.Errorf("result = %d upserted, %d failed, want 1000 and 500", result.Upserted, len(result.Failed))
+	}
+	if !IsValidationError(result.Failed[0].Err) {
+		t.Errorf("failure Err = %v, want the 422 APIError", result.Failed[0].Err)
+	}
+}
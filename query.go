@@ -0,0 +1,143 @@
+package bigcommerce
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ListOptions holds the page-based pagination parameters shared by list endpoints.
+type ListOptions struct {
+	Page  int `url:"page,omitempty"`
+	Limit int `url:"limit,omitempty"`
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// addQuery encodes the `url` tagged fields of options and appends them to path.
+// Nil pointers, empty slices and, with omitempty, zero values are skipped.
+// Slices are joined with commas, as BigCommerce expects for :in filters.
+func addQuery(path string, options interface{}) (string, error) {
+	values := url.Values{}
+	if err := encodeQuery(values, reflect.ValueOf(options)); err != nil {
+		return path, err
+	}
+
+	if len(values) == 0 {
+		return path, nil
+	}
+
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+
+	return path + separator + queryEscaper.Replace(values.Encode()), nil
+}
+
+// queryEscaper keeps the colons of filter names such as id:in and the commas
+// of list values readable; both are valid unescaped in a query string.
+var queryEscaper = strings.NewReplacer("%3A", ":", "%2C", ",")
+
+func encodeQuery(values url.Values, v reflect.Value) error {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	if !v.IsValid() {
+		return nil
+	}
+	if v.Kind() != reflect.Struct {
+		return fmt.Errorf("bigcommerce: query options must be a struct, got %s", v.Kind())
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		value := v.Field(i)
+
+		if field.Anonymous && field.Tag.Get("url") == "" {
+			if err := encodeQuery(values, value); err != nil {
+				return err
+			}
+			continue
+		}
+
+		tag := field.Tag.Get("url")
+		if tag == "" || tag == "-" {
+			continue
+		}
+
+		name := tag
+		omitEmpty := false
+		if comma := strings.Index(tag, ","); comma >= 0 {
+			name = tag[:comma]
+			omitEmpty = strings.Contains(tag[comma:], "omitempty")
+		}
+
+		if value.Kind() == reflect.Ptr {
+			if value.IsNil() {
+				continue
+			}
+			value = value.Elem()
+		} else if omitEmpty && value.IsZero() {
+			continue
+		}
+
+		s, ok, err := queryValue(value)
+		if err != nil {
+			return fmt.Errorf("bigcommerce: query field %s: %v", field.Name, err)
+		}
+		if ok {
+			values.Set(name, s)
+		}
+	}
+
+	return nil
+}
+
+func queryValue(v reflect.Value) (string, bool, error) {
+	if v.Type() == timeType {
+		t := v.Interface().(time.Time)
+		if t.IsZero() {
+			return "", false, nil
+		}
+		return t.Format(time.RFC3339), true, nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true, nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true, nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), true, nil
+	case reflect.Slice, reflect.Array:
+		if v.Len() == 0 {
+			return "", false, nil
+		}
+		parts := make([]string, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			s, ok, err := queryValue(v.Index(i))
+			if err != nil {
+				return "", false, err
+			}
+			if ok {
+				parts = append(parts, s)
+			}
+		}
+		return strings.Join(parts, ","), len(parts) > 0, nil
+	}
+
+	return "", false, fmt.Errorf("unsupported kind %s", v.Kind())
+}
@@ -0,0 +1,74 @@
+package bigcommerce
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAddQuery(t *testing.T) {
+	type embedded struct {
+		Include []string `url:"include,omitempty"`
+	}
+	type options struct {
+		ListOptions
+		embedded
+		IDIn      []int     `url:"id:in,omitempty"`
+		Name      string    `url:"name,omitempty"`
+		Keyword   string    `url:"keyword"`
+		IsVisible *bool     `url:"is_visible,omitempty"`
+		ParentID  *int      `url:"parent_id,omitempty"`
+		Price     float64   `url:"price,omitempty"`
+		Modified  time.Time `url:"date_modified:min,omitempty"`
+		Ignored   string    `url:"-"`
+		Untagged  string
+	}
+
+	visible, parentID := false, 0
+	modified := time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		path    string
+		options interface{}
+		want    string
+	}{
+		{"nil options", "/v3/widgets", nil, "/v3/widgets"},
+		{"nil pointer", "/v3/widgets", (*options)(nil), "/v3/widgets"},
+		{"zero values kept only without omitempty", "/v3/widgets", options{}, "/v3/widgets?keyword="},
+		{
+			"every kind",
+			"/v3/widgets",
+			&options{
+				ListOptions: ListOptions{Page: 2, Limit: 50},
+				embedded:    embedded{Include: []string{"variants", "images"}},
+				IDIn:        []int{1, 2, 3},
+				Name:        "a b",
+				Keyword:     "shirt",
+				Price:       9.5,
+				Modified:    modified,
+				Ignored:     "x",
+				Untagged:    "y",
+			},
+			"/v3/widgets?date_modified:min=2024-03-01T12:30:00Z&id:in=1,2,3&include=variants,images&keyword=shirt&limit=50&name=a+b&page=2&price=9.5",
+		},
+		{"non-nil pointers to zero values", "/v3/categories", options{IsVisible: &visible, ParentID: &parentID, Keyword: "x"}, "/v3/categories?is_visible=false&keyword=x&parent_id=0"},
+		{"existing query", "/v3/redirects?site_id=1", ListOptions{Page: 3}, "/v3/redirects?site_id=1&page=3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := addQuery(tt.path, tt.options)
+			if err != nil {
+				t.Fatalf("addQuery: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("addQuery() = %q\nwant %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddQueryRejectsNonStructs(t *testing.T) {
+	if _, err := addQuery("/v3/widgets", map[string]string{"a": "b"}); err == nil {
+		t.Error("addQuery accepted a map")
+	}
+}
@@ -0,0 +1,123 @@
+package bigcommerce
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// maxRateLimitRetries is the number of times a 429 response is retried
+// after waiting for the advertised reset time.
+const maxRateLimitRetries = 3
+
+// defaultRateLimitWait is used when a 429 response doesn't say when the
+// quota window resets.
+const defaultRateLimitWait = time.Second
+
+// RateLimit is the per-store request quota last reported by BigCommerce
+// through the X-Rate-Limit-* response headers.
+type RateLimit struct {
+	// RequestsLeft is the number of requests remaining in the current window.
+	RequestsLeft int
+	// Quota is the number of requests allowed per window.
+	Quota int
+	// Window is the length of the quota window.
+	Window time.Duration
+	// ResetAt is when the current window ends and the quota is replenished.
+	ResetAt time.Time
+	// UpdatedAt is when the headers were last observed. It is zero until
+	// the first response carrying rate limit headers has been received.
+	UpdatedAt time.Time
+}
+
+type rateLimiter struct {
+	mu    sync.Mutex
+	state RateLimit
+}
+
+// current returns a copy of the last observed quota state.
+func (r *rateLimiter) current() RateLimit {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.state
+}
+
+// update records the quota state from the response headers, if present.
+func (r *rateLimiter) update(header http.Header) {
+	left, ok := headerInt(header, "X-Rate-Limit-Requests-Left")
+	if !ok {
+		return
+	}
+
+	now := time.Now()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.state.RequestsLeft = left
+	r.state.UpdatedAt = now
+	if quota, ok := headerInt(header, "X-Rate-Limit-Requests-Quota"); ok {
+		r.state.Quota = quota
+	}
+	if window, ok := headerInt(header, "X-Rate-Limit-Time-Window-Ms"); ok {
+		r.state.Window = time.Duration(window) * time.Millisecond
+	}
+	if reset, ok := headerInt(header, "X-Rate-Limit-Time-Reset-Ms"); ok {
+		r.state.ResetAt = now.Add(time.Duration(reset) * time.Millisecond)
+	}
+}
+
+// wait blocks until the quota window resets when no requests are left in
+// it, returning early with the context's error if ctx is done first.
+func (r *rateLimiter) wait(ctx context.Context) error {
+	state := r.current()
+	if state.UpdatedAt.IsZero() || state.RequestsLeft > 0 {
+		return nil
+	}
+
+	return sleepContext(ctx, time.Until(state.ResetAt))
+}
+
+// retryAfter returns how long to wait before retrying a 429 response.
+func retryAfter(header http.Header) time.Duration {
+	if reset, ok := headerInt(header, "X-Rate-Limit-Time-Reset-Ms"); ok && reset > 0 {
+		return time.Duration(reset) * time.Millisecond
+	}
+	if seconds, ok := headerInt(header, "Retry-After"); ok && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	return defaultRateLimitWait
+}
+
+func headerInt(header http.Header, key string) (int, bool) {
+	value := header.Get(key)
+	if value == "" {
+		return 0, false
+	}
+
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, false
+	}
+
+	return i, true
+}
+
+// sleepContext pauses for d or until ctx is done, whichever happens first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
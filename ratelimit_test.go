@@ -0,0 +1,76 @@
+package bigcommerce
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterUpdate(t *testing.T) {
+	var limiter rateLimiter
+
+	limiter.update(http.Header{})
+	if state := limiter.current(); !state.UpdatedAt.IsZero() {
+		t.Fatalf("state updated without rate limit headers: %+v", state)
+	}
+
+	header := http.Header{}
+	header.Set("X-Rate-Limit-Requests-Left", "7")
+	header.Set("X-Rate-Limit-Requests-Quota", "150")
+	header.Set("X-Rate-Limit-Time-Window-Ms", "30000")
+	header.Set("X-Rate-Limit-Time-Reset-Ms", "1500")
+	limiter.update(header)
+
+	state := limiter.current()
+	if state.RequestsLeft != 7 || state.Quota != 150 || state.Window != 30*time.Second {
+		t.Errorf("state = %+v", state)
+	}
+	if reset := time.Until(state.ResetAt); reset <= time.Second || reset > 1500*time.Millisecond {
+		t.Errorf("ResetAt is %s away, want about 1.5s", reset)
+	}
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	var limiter rateLimiter
+	if err := limiter.wait(context.Background()); err != nil {
+		t.Fatalf("wait before any response: %v", err)
+	}
+
+	header := http.Header{}
+	header.Set("X-Rate-Limit-Requests-Left", "0")
+	header.Set("X-Rate-Limit-Time-Reset-Ms", "60000")
+	limiter.update(header)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := limiter.wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("wait with an exhausted quota = %v, want the context's error", err)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		header map[string]string
+		want   time.Duration
+	}{
+		{"reset header", map[string]string{"X-Rate-Limit-Time-Reset-Ms": "250"}, 250 * time.Millisecond},
+		{"retry-after header", map[string]string{"Retry-After": "2"}, 2 * time.Second},
+		{"reset header wins", map[string]string{"X-Rate-Limit-Time-Reset-Ms": "250", "Retry-After": "2"}, 250 * time.Millisecond},
+		{"no headers", nil, defaultRateLimitWait},
+		{"invalid header", map[string]string{"X-Rate-Limit-Time-Reset-Ms": "soon"}, defaultRateLimitWait},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			for key, value := range tt.header {
+				header.Set(key, value)
+			}
+			if got := retryAfter(header); got != tt.want {
+				t.Errorf("retryAfter() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
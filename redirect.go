@@ -0,0 +1,531 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// MaxRedirectBatchSize is the number of redirects Upsert sends in a single request.
+const MaxRedirectBatchSize = 100
+
+// Redirect target types, as used by RedirectTarget.Type.
+const (
+	RedirectTargetProduct  = "product"
+	RedirectTargetBrand    = "brand"
+	RedirectTargetCategory = "category"
+	RedirectTargetPage     = "page"
+	RedirectTargetPost     = "post"
+	RedirectTargetURL      = "url"
+)
+
+// Redirect job statuses, as used by RedirectJob.Status.
+const (
+	RedirectJobNew      = "new"
+	RedirectJobWorking  = "working"
+	RedirectJobComplete = "complete"
+	RedirectJobAborted  = "aborted"
+	RedirectJobFailed   = "failed"
+)
+
+// RedirectIncludeToURL returns the resolved target URL with each redirect.
+const RedirectIncludeToURL = "to_url"
+
+// RedirectService manages the 301 redirects of sites.
+type RedirectService interface {
+	List(...ListRedirectsOptions) (ListRedirectResponse, error)
+	ListWithContext(context.Context, ...ListRedirectsOptions) (ListRedirectResponse, error)
+	Upsert([]Redirect) ([]Redirect, error)
+	UpsertWithContext(context.Context, []Redirect) ([]Redirect, error)
+	Delete([]int) error
+	DeleteWithContext(context.Context, []int) error
+	DeleteAll(int) error
+	DeleteAllWithContext(context.Context, int) error
+	Iterate(context.Context, IteratorOptions, ...ListRedirectsOptions) *Iterator
+	Import(string, io.Reader) (string, error)
+	ImportWithContext(context.Context, string, io.Reader) (string, error)
+	ImportAndWait(string, io.Reader, ...JobWaitOptions) (RedirectJob, error)
+	ImportAndWaitWithContext(context.Context, string, io.Reader, ...JobWaitOptions) (RedirectJob, error)
+	Export(RedirectExport) (string, error)
+	ExportWithContext(context.Context, RedirectExport) (string, error)
+	ExportAndWait(RedirectExport, ...JobWaitOptions) ([]byte, error)
+	ExportAndWaitWithContext(context.Context, RedirectExport, ...JobWaitOptions) ([]byte, error)
+	DownloadExport(string) ([]byte, error)
+	DownloadExportWithContext(context.Context, string) ([]byte, error)
+	ListJobs(...ListRedirectJobsOptions) (ListRedirectJobResponse, error)
+	ListJobsWithContext(context.Context, ...ListRedirectJobsOptions) (ListRedirectJobResponse, error)
+	GetJob(string) (RedirectJob, error)
+	GetJobWithContext(context.Context, string) (RedirectJob, error)
+	WaitForJob(string, ...JobWaitOptions) (RedirectJob, error)
+	WaitForJobWithContext(context.Context, string, ...JobWaitOptions) (RedirectJob, error)
+}
+
+type ListRedirectResponse struct {
+	Data []Redirect `json:"data"`
+	Meta MetaResult `json:"meta"`
+}
+
+type ListRedirectJobResponse struct {
+	Data []RedirectJob `json:"data"`
+	Meta MetaResult    `json:"meta"`
+}
+
+// Redirect is a 301 redirect from a path of a site.
+type Redirect struct {
+	ID       int            `json:"id,omitempty"`
+	SiteID   int            `json:"site_id"`
+	FromPath string         `json:"from_path"`
+	To       RedirectTarget `json:"to"`
+	ToURL    string         `json:"to_url,omitempty"`
+}
+
+// RedirectTarget is where a redirect points: a catalog or content entity
+// by EntityID, or, for RedirectTargetURL, a URL.
+type RedirectTarget struct {
+	Type     string `json:"type"`
+	EntityID int    `json:"entity_id,omitempty"`
+	URL      string `json:"url,omitempty"`
+}
+
+// RedirectExport selects the redirects to export: those of SiteID, or with
+// one of RedirectIDs. Leave both empty to export every redirect.
+type RedirectExport struct {
+	SiteID      int   `json:"site_id,omitempty"`
+	RedirectIDs []int `json:"redirect_ids,omitempty"`
+}
+
+// RedirectJob is an asynchronous redirect import or export.
+type RedirectJob struct {
+	ID             string               `json:"id"`
+	Type           string               `json:"type"`
+	Status         string               `json:"status"`
+	SiteID         int                  `json:"site_id,omitempty"`
+	ItemsTotal     int                  `json:"items_total"`
+	ItemsProcessed int                  `json:"items_processed"`
+	Errors         []RedirectJobMessage `json:"errors"`
+	CreatedAt      string               `json:"created_at"`
+	CompletedAt    string               `json:"completed_at"`
+}
+
+// RedirectJobMessage is an error reported by a redirect job, such as a
+// CSV row that could not be imported.
+type RedirectJobMessage struct {
+	Row     int    `json:"row,omitempty"`
+	Message string `json:"message"`
+}
+
+// RedirectJobError is returned when a redirect job fails or is aborted.
+type RedirectJobError struct {
+	Job RedirectJob
+}
+
+func (e *RedirectJobError) Error() string {
+	msg := fmt.Sprintf("bigcommerce: redirect %s job %s %s", e.Job.Type, e.Job.ID, e.Job.Status)
+	if len(e.Job.Errors) > 0 {
+		messages := make([]string, 0, len(e.Job.Errors))
+		for _, message := range e.Job.Errors {
+			messages = append(messages, message.Message)
+		}
+		msg += ": " + strings.Join(messages, "; ")
+	}
+	return msg
+}
+
+// RedirectUpsertError is returned by Upsert when the API rejects one or more
+// batches. The redirects of the other batches are saved; Err is the error of
+// the first rejected batch. When a later batch fails for another reason, such
+// as a network error, Upsert stops and Err is that error instead; the
+// redirects after it are neither saved nor listed in Failures.
+type RedirectUpsertError struct {
+	Failures []RedirectFailure
+	Err      error
+}
+
+// RedirectFailure is a redirect that Upsert could not save.
+type RedirectFailure struct {
+	// Index is the position of the redirect in the slice passed to Upsert.
+	Index    int
+	Redirect Redirect
+	// Message is the API's reason for rejecting this redirect, when it gave
+	// one; redirects without one were rejected along with the rest of their batch.
+	Message string
+}
+
+func (e *RedirectUpsertError) Error() string {
+	return fmt.Sprintf("bigcommerce: %d redirects failed to upsert: %v", len(e.Failures), e.Err)
+}
+
+func (e *RedirectUpsertError) Unwrap() error {
+	return e.Err
+}
+
+// redirectFailures lists the redirects of a rejected batch, which starts at
+// offset in the redirects passed to Upsert. Redirects the API reports errors
+// against by position ("3.from_path") get those errors as their Message.
+func redirectFailures(batch []Redirect, offset int, apiErr *APIError) []RedirectFailure {
+	messages := map[int][]string{}
+	for key, message := range apiErr.Errors {
+		if index, ok := batchErrorIndex(key); ok {
+			messages[index] = append(messages[index], key+": "+message)
+		}
+	}
+
+	failures := make([]RedirectFailure, 0, len(batch))
+	for index, redirect := range batch {
+		redirectMessages := messages[index]
+		sort.Strings(redirectMessages)
+		failures = append(failures, RedirectFailure{Index: offset + index, Redirect: redirect, Message: strings.Join(redirectMessages, "; ")})
+	}
+	return failures
+}
+
+// ListRedirectsOptions filters the redirects returned by List.
+type ListRedirectsOptions struct {
+	ListOptions
+	SiteID    int      `url:"site_id,omitempty"`
+	IDIn      []int    `url:"id:in,omitempty"`
+	Keyword   string   `url:"keyword,omitempty"`
+	Sort      string   `url:"sort,omitempty"`
+	Direction string   `url:"direction,omitempty"`
+	Include   []string `url:"include,omitempty"`
+}
+
+// ListRedirectJobsOptions filters the jobs returned by ListJobs.
+type ListRedirectJobsOptions struct {
+	ListOptions
+	IDIn   []string `url:"id:in,omitempty"`
+	Type   string   `url:"type,omitempty"`
+	Status string   `url:"status,omitempty"`
+}
+
+type redirectJobIDResponse struct {
+	ID string `json:"id"`
+}
+
+type RedirectServiceOp struct {
+	client *Client
+}
+
+// List will return a page of redirects matching the options.
+func (s *RedirectServiceOp) List(options ...ListRedirectsOptions) (ListRedirectResponse, error) {
+	return s.ListWithContext(context.Background(), options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *RedirectServiceOp) ListWithContext(ctx context.Context, options ...ListRedirectsOptions) (ListRedirectResponse, error) {
+	listResult := ListRedirectResponse{}
+
+	var listOptions ListRedirectsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/storefront/redirects", listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// Upsert will create, or update, the redirects with the same SiteID and FromPath,
+// sending them in batches of MaxRedirectBatchSize. Batches the API rejects are
+// reported in a *RedirectUpsertError once every batch has been sent.
+func (s *RedirectServiceOp) Upsert(redirects []Redirect) ([]Redirect, error) {
+	return s.UpsertWithContext(context.Background(), redirects)
+}
+
+// UpsertWithContext is the context-aware variant of Upsert.
+func (s *RedirectServiceOp) UpsertWithContext(ctx context.Context, redirects []Redirect) ([]Redirect, error) {
+	upserted := []Redirect{}
+	var upsertErr *RedirectUpsertError
+	for start := 0; start < len(redirects); start += MaxRedirectBatchSize {
+		end := start + MaxRedirectBatchSize
+		if end > len(redirects) {
+			end = len(redirects)
+		}
+
+		batch, err := s.upsertBatch(ctx, redirects[start:end])
+		if err != nil {
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnprocessableEntity {
+				if upsertErr != nil {
+					upsertErr.Err = err
+					return upserted, upsertErr
+				}
+				return upserted, err
+			}
+			if upsertErr == nil {
+				upsertErr = &RedirectUpsertError{Err: err}
+			}
+			upsertErr.Failures = append(upsertErr.Failures, redirectFailures(redirects[start:end], start, apiErr)...)
+			continue
+		}
+		upserted = append(upserted, batch...)
+	}
+
+	if upsertErr != nil {
+		return upserted, upsertErr
+	}
+	return upserted, nil
+}
+
+func (s *RedirectServiceOp) upsertBatch(ctx context.Context, redirects []Redirect) ([]Redirect, error) {
+	listResult := ListRedirectResponse{}
+	jsonBody, err := json.Marshal(redirects)
+	if err != nil {
+		return listResult.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, "/v3/storefront/redirects", reqBody)
+	if reqErr != nil {
+		return listResult.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult.Data, jsonErr
+	}
+	return listResult.Data, nil
+}
+
+// Delete will delete every redirect with one of the provided IDs.
+func (s *RedirectServiceOp) Delete(ids []int) error {
+	return s.DeleteWithContext(context.Background(), ids)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *RedirectServiceOp) DeleteWithContext(ctx context.Context, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	path, err := addQuery("/v3/storefront/redirects", ListRedirectsOptions{IDIn: ids})
+	if err != nil {
+		return err
+	}
+
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, path, nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// DeleteAll will delete every redirect of a site. siteID must not be zero.
+func (s *RedirectServiceOp) DeleteAll(siteID int) error {
+	return s.DeleteAllWithContext(context.Background(), siteID)
+}
+
+// DeleteAllWithContext is the context-aware variant of DeleteAll.
+func (s *RedirectServiceOp) DeleteAllWithContext(ctx context.Context, siteID int) error {
+	if siteID == 0 {
+		return errors.New("bigcommerce: DeleteAll needs a site ID")
+	}
+
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/storefront/redirects?site_id=%d", siteID), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// Iterate will walk every redirect matching the options, fetching further pages as needed.
+func (s *RedirectServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, options ...ListRedirectsOptions) *Iterator {
+	var listOptions ListRedirectsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListWithContext(ctx, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
+
+// Import will start a job importing redirects from a CSV file, in the format
+// written by WriteRedirectsCSV, and return the ID of the job.
+func (s *RedirectServiceOp) Import(filename string, csv io.Reader) (string, error) {
+	return s.ImportWithContext(context.Background(), filename, csv)
+}
+
+// ImportWithContext is the context-aware variant of Import.
+func (s *RedirectServiceOp) ImportWithContext(ctx context.Context, filename string, csv io.Reader) (string, error) {
+	jobResponse := redirectJobIDResponse{}
+	body, reqErr := s.client.DoMultipartRequestWithContext(ctx, http.MethodPost, "/v3/storefront/redirects/imex/import", nil, MultipartFile{Field: "import_file", Filename: filename, Reader: csv})
+	if reqErr != nil {
+		return "", reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &jobResponse)
+	if jsonErr != nil {
+		return "", jsonErr
+	}
+	return jobResponse.ID, nil
+}
+
+// ImportAndWait will import redirects from a CSV file and wait for the job to finish.
+func (s *RedirectServiceOp) ImportAndWait(filename string, csv io.Reader, options ...JobWaitOptions) (RedirectJob, error) {
+	return s.ImportAndWaitWithContext(context.Background(), filename, csv, options...)
+}
+
+// ImportAndWaitWithContext is the context-aware variant of ImportAndWait.
+func (s *RedirectServiceOp) ImportAndWaitWithContext(ctx context.Context, filename string, csv io.Reader, options ...JobWaitOptions) (RedirectJob, error) {
+	jobID, err := s.ImportWithContext(ctx, filename, csv)
+	if err != nil {
+		return RedirectJob{}, err
+	}
+	return s.WaitForJobWithContext(ctx, jobID, options...)
+}
+
+// Export will start a job exporting redirects as CSV and return the ID of the job.
+func (s *RedirectServiceOp) Export(export RedirectExport) (string, error) {
+	return s.ExportWithContext(context.Background(), export)
+}
+
+// ExportWithContext is the context-aware variant of Export.
+func (s *RedirectServiceOp) ExportWithContext(ctx context.Context, export RedirectExport) (string, error) {
+	jobResponse := redirectJobIDResponse{}
+	jsonBody, err := json.Marshal(export)
+	if err != nil {
+		return "", err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, "/v3/storefront/redirects/imex/export", reqBody)
+	if reqErr != nil {
+		return "", reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &jobResponse)
+	if jsonErr != nil {
+		return "", jsonErr
+	}
+	return jobResponse.ID, nil
+}
+
+// ExportAndWait will export redirects, wait for the job to finish and return the CSV file.
+func (s *RedirectServiceOp) ExportAndWait(export RedirectExport, options ...JobWaitOptions) ([]byte, error) {
+	return s.ExportAndWaitWithContext(context.Background(), export, options...)
+}
+
+// ExportAndWaitWithContext is the context-aware variant of ExportAndWait.
+func (s *RedirectServiceOp) ExportAndWaitWithContext(ctx context.Context, export RedirectExport, options ...JobWaitOptions) ([]byte, error) {
+	jobID, err := s.ExportWithContext(ctx, export)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.WaitForJobWithContext(ctx, jobID, options...); err != nil {
+		return nil, err
+	}
+	return s.DownloadExportWithContext(ctx, jobID)
+}
+
+// DownloadExport will return the CSV file of a completed export job.
+func (s *RedirectServiceOp) DownloadExport(jobID string) ([]byte, error) {
+	return s.DownloadExportWithContext(context.Background(), jobID)
+}
+
+// DownloadExportWithContext is the context-aware variant of DownloadExport.
+func (s *RedirectServiceOp) DownloadExportWithContext(ctx context.Context, jobID string) ([]byte, error) {
+	header := http.Header{"Accept": {"text/csv"}}
+	return s.client.doRequest(ctx, http.MethodGet, fmt.Sprintf("/v3/storefront/redirects/imex/export/%s/download", jobID), header, nil)
+}
+
+// ListJobs will return a page of import and export jobs matching the options.
+func (s *RedirectServiceOp) ListJobs(options ...ListRedirectJobsOptions) (ListRedirectJobResponse, error) {
+	return s.ListJobsWithContext(context.Background(), options...)
+}
+
+// ListJobsWithContext is the context-aware variant of ListJobs.
+func (s *RedirectServiceOp) ListJobsWithContext(ctx context.Context, options ...ListRedirectJobsOptions) (ListRedirectJobResponse, error) {
+	listResult := ListRedirectJobResponse{}
+
+	var listOptions ListRedirectJobsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/storefront/redirects/imex/jobs", listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// GetJob will fetch the state of an import or export job.
+func (s *RedirectServiceOp) GetJob(jobID string) (RedirectJob, error) {
+	return s.GetJobWithContext(context.Background(), jobID)
+}
+
+// GetJobWithContext is the context-aware variant of GetJob.
+func (s *RedirectServiceOp) GetJobWithContext(ctx context.Context, jobID string) (RedirectJob, error) {
+	listResult, err := s.ListJobsWithContext(ctx, ListRedirectJobsOptions{IDIn: []string{jobID}})
+	if err != nil {
+		return RedirectJob{}, err
+	}
+	if len(listResult.Data) == 0 {
+		return RedirectJob{}, fmt.Errorf("bigcommerce: redirect job %s not found", jobID)
+	}
+	return listResult.Data[0], nil
+}
+
+// WaitForJob will poll an import or export job until it finishes, or ctx is done.
+// Jobs that fail or are aborted are returned with a *RedirectJobError.
+func (s *RedirectServiceOp) WaitForJob(jobID string, options ...JobWaitOptions) (RedirectJob, error) {
+	return s.WaitForJobWithContext(context.Background(), jobID, options...)
+}
+
+// WaitForJobWithContext is the context-aware variant of WaitForJob.
+func (s *RedirectServiceOp) WaitForJobWithContext(ctx context.Context, jobID string, options ...JobWaitOptions) (RedirectJob, error) {
+	interval := jobPollInterval(options)
+	for {
+		job, err := s.GetJobWithContext(ctx, jobID)
+		if err != nil {
+			return job, err
+		}
+
+		switch job.Status {
+		case RedirectJobComplete:
+			return job, nil
+		case RedirectJobFailed, RedirectJobAborted:
+			return job, &RedirectJobError{Job: job}
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			return job, err
+		}
+	}
+}
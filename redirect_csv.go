@@ -0,0 +1,134 @@
+package bigcommerce
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// The columns of the redirect import/export CSV format.
+const (
+	redirectCSVDomain     = "Domain"
+	redirectCSVOldPath    = "Old Path"
+	redirectCSVManualURL  = "Manual URL/Path"
+	redirectCSVTargetType = "Dynamic Target Type"
+	redirectCSVTargetID   = "Dynamic Target ID"
+)
+
+var redirectCSVHeader = []string{
+	redirectCSVDomain,
+	redirectCSVOldPath,
+	redirectCSVManualURL,
+	redirectCSVTargetType,
+	redirectCSVTargetID,
+}
+
+// WriteRedirectsCSV will write redirects to w in the CSV format accepted by
+// RedirectService.Import, with every row on domain.
+func WriteRedirectsCSV(w io.Writer, domain string, redirects []Redirect) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(redirectCSVHeader); err != nil {
+		return err
+	}
+
+	for _, redirect := range redirects {
+		row := []string{domain, redirect.FromPath, "", "", ""}
+		if redirect.To.Type == RedirectTargetURL || redirect.To.Type == "" {
+			row[2] = redirect.To.URL
+		} else {
+			row[3] = redirect.To.Type
+			row[4] = strconv.Itoa(redirect.To.EntityID)
+		}
+
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// ReadRedirectsCSV will read redirects from a CSV file in the format written
+// by WriteRedirectsCSV or RedirectService.DownloadExport. Each row is assigned
+// the site ID that sites maps its domain to; rows with any other domain are an
+// error. Columns are matched by header name, so their order does not matter.
+func ReadRedirectsCSV(r io.Reader, sites map[string]int) ([]Redirect, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{redirectCSVDomain, redirectCSVOldPath} {
+		if _, ok := columns[strings.ToLower(name)]; !ok {
+			return nil, fmt.Errorf("bigcommerce: redirect CSV has no %q column", name)
+		}
+	}
+
+	siteIDs := make(map[string]int, len(sites))
+	for domain, siteID := range sites {
+		siteIDs[strings.ToLower(domain)] = siteID
+	}
+
+	field := func(row []string, name string) string {
+		i, ok := columns[strings.ToLower(name)]
+		if !ok || i >= len(row) {
+			return ""
+		}
+		return strings.TrimSpace(row[i])
+	}
+	// line reports where the named field of the last row read starts, or
+	// where the row starts when it is too short to have the field.
+	line := func(row []string, name string) int {
+		i, ok := columns[strings.ToLower(name)]
+		if !ok || i >= len(row) {
+			i = 0
+		}
+		line, _ := reader.FieldPos(i)
+		return line
+	}
+
+	redirects := []Redirect{}
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return redirects, err
+		}
+
+		domain := field(row, redirectCSVDomain)
+		siteID, ok := siteIDs[strings.ToLower(domain)]
+		if !ok {
+			return redirects, fmt.Errorf("bigcommerce: redirect CSV line %d: unknown domain %q", line(row, redirectCSVDomain), domain)
+		}
+
+		redirect := Redirect{SiteID: siteID, FromPath: field(row, redirectCSVOldPath)}
+		if targetType := field(row, redirectCSVTargetType); targetType != "" {
+			entityID, err := strconv.Atoi(field(row, redirectCSVTargetID))
+			if err != nil {
+				return redirects, fmt.Errorf("bigcommerce: redirect CSV line %d: invalid %s: %v", line(row, redirectCSVTargetID), redirectCSVTargetID, err)
+			}
+			redirect.To = RedirectTarget{Type: strings.ToLower(targetType), EntityID: entityID}
+		} else {
+			redirect.To = RedirectTarget{Type: RedirectTargetURL, URL: field(row, redirectCSVManualURL)}
+		}
+
+		redirects = append(redirects, redirect)
+	}
+
+	return redirects, nil
+}
@@ -0,0 +1,104 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRedirectsCSVRoundTrip(t *testing.T) {
+	redirects := []Redirect{
+		{SiteID: 1000, FromPath: "/old-shirt", To: RedirectTarget{Type: RedirectTargetProduct, EntityID: 42}},
+		{SiteID: 1000, FromPath: "/sale", To: RedirectTarget{Type: RedirectTargetURL, URL: "https://example.com/offers"}},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteRedirectsCSV(&buf, "shop.example.com", redirects); err != nil {
+		t.Fatalf("WriteRedirectsCSV: %v", err)
+	}
+
+	want := "Domain,Old Path,Manual URL/Path,Dynamic Target Type,Dynamic Target ID\n" +
+		"shop.example.com,/old-shirt,,product,42\n" +
+		"shop.example.com,/sale,https://example.com/offers,,\n"
+	if buf.String() != want {
+		t.Errorf("CSV = %q\nwant %q", buf.String(), want)
+	}
+
+	got, err := ReadRedirectsCSV(&buf, map[string]int{"shop.example.com": 1000})
+	if err != nil {
+		t.Fatalf("ReadRedirectsCSV: %v", err)
+	}
+	if !reflect.DeepEqual(got, redirects) {
+		t.Errorf("redirects = %+v\nwant %+v", got, redirects)
+	}
+}
+
+func TestReadRedirectsCSV(t *testing.T) {
+	sites := map[string]int{"Shop.Example.com": 1000, "uk.example.com": 1001}
+	tests := []struct {
+		name    string
+		csv     string
+		want    []Redirect
+		wantErr string
+	}{
+		{
+			name: "reordered headers and domain case",
+			csv: "Dynamic Target ID,Old Path,Domain,Dynamic Target Type\n" +
+				"7,/old-category,SHOP.example.com,Category\n" +
+				",/moved,uk.example.com,\n",
+			want: []Redirect{
+				{SiteID: 1000, FromPath: "/old-category", To: RedirectTarget{Type: RedirectTargetCategory, EntityID: 7}},
+				{SiteID: 1001, FromPath: "/moved", To: RedirectTarget{Type: RedirectTargetURL}},
+			},
+		},
+		{
+			name: "header only",
+			csv:  "Domain,Old Path\n",
+			want: []Redirect{},
+		},
+		{
+			name:    "missing domain column",
+			csv:     "Old Path,Manual URL/Path\n/a,/b\n",
+			wantErr: `bigcommerce: redirect CSV has no "Domain" column`,
+		},
+		{
+			name:    "unknown domain",
+			csv:     "Domain,Old Path,Manual URL/Path\nshop.example.com,/a,/b\nother.example.com,/c,/d\n",
+			want:    []Redirect{{SiteID: 1000, FromPath: "/a", To: RedirectTarget{Type: RedirectTargetURL, URL: "/b"}}},
+			wantErr: `bigcommerce: redirect CSV line 3: unknown domain "other.example.com"`,
+		},
+		{
+			name:    "bad target ID",
+			csv:     "Domain,Old Path,Dynamic Target Type,Dynamic Target ID\nshop.example.com,/a,product,forty-two\n",
+			want:    []Redirect{},
+			wantErr: `bigcommerce: redirect CSV line 2: invalid Dynamic Target ID`,
+		},
+		{
+			name: "short rows",
+			csv:  "Domain,Old Path,Manual URL/Path,Dynamic Target Type,Dynamic Target ID\nshop.example.com,/a\n",
+			want: []Redirect{{SiteID: 1000, FromPath: "/a", To: RedirectTarget{Type: RedirectTargetURL}}},
+		},
+		{
+			name:    "short row missing its domain",
+			csv:     "Old Path,Manual URL/Path,Domain\n/a,/b,shop.example.com\n\"/c\nd\"\n",
+			want:    []Redirect{{SiteID: 1000, FromPath: "/a", To: RedirectTarget{Type: RedirectTargetURL, URL: "/b"}}},
+			wantErr: `bigcommerce: redirect CSV line 3: unknown domain ""`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadRedirectsCSV(strings.NewReader(tt.csv), sites)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("ReadRedirectsCSV: %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.HasPrefix(err.Error(), tt.wantErr)) {
+				t.Fatalf("err = %v, want %q", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("redirects = %+v\nwant %+v", got, tt.want)
+			}
+		})
+	}
+}
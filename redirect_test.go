@@ -0,0 +1,93 @@
+package bigcommerce
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func testRedirects(n int) []Redirect {
+	redirects := make([]Redirect, n)
+	for i := range redirects {
+		redirects[i] = Redirect{SiteID: 1000, FromPath: fmt.Sprintf("/old-%d", i), To: RedirectTarget{Type: RedirectTargetURL, URL: "/new"}}
+	}
+	return redirects
+}
+
+func TestRedirectsUpsertReportsFailedBatches(t *testing.T) {
+	tests := []struct {
+		name         string
+		lastStatus   int
+		wantStatus   int
+		wantUpserted int
+		wantFailures int
+	}{
+		{"later batches still sent", http.StatusOK, http.StatusUnprocessableEntity, 150, 100},
+		{"later transport failure keeps failures", http.StatusServiceUnavailable, http.StatusServiceUnavailable, 100, 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int32
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				var batch []Redirect
+				body, _ := ioutil.ReadAll(r.Body)
+				if err := json.Unmarshal(body, &batch); err != nil || len(batch) > MaxRedirectBatchSize {
+					t.Errorf("batch of %d redirects: %v", len(batch), err)
+				}
+
+				switch atomic.AddInt32(&calls, 1) {
+				case 2:
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					w.Write([]byte(`{"status":422,"title":"Invalid redirects","errors":{"3.from_path":"already exists"}}`))
+					return
+				case 3:
+					if tt.lastStatus != http.StatusOK {
+						w.WriteHeader(tt.lastStatus)
+						return
+					}
+				}
+				response, _ := json.Marshal(ListRedirectResponse{Data: batch})
+				w.Write(response)
+			})
+
+			upserted, err := client.Storefront.Redirects.Upsert(testRedirects(250))
+
+			var upsertErr *RedirectUpsertError
+			if !errors.As(err, &upsertErr) {
+				t.Fatalf("err = %v, want a *RedirectUpsertError", err)
+			}
+			if len(upserted) != tt.wantUpserted || len(upsertErr.Failures) != tt.wantFailures {
+				t.Errorf("upserted %d with %d failures, want %d with %d", len(upserted), len(upsertErr.Failures), tt.wantUpserted, tt.wantFailures)
+			}
+			failure := upsertErr.Failures[3]
+			if failure.Index != 103 || failure.Redirect.FromPath != "/old-103" || failure.Message != "3.from_path: already exists" {
+				t.Errorf("failure = %+v", failure)
+			}
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != tt.wantStatus {
+				t.Errorf("err = %v, want it to wrap a %d APIError", err, tt.wantStatus)
+			}
+			if calls != 3 {
+				t.Errorf("calls = %d, want 3", calls)
+			}
+		})
+	}
+}
+
+func TestRedirectsUpsertReturnsOtherErrors(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, err := client.Storefront.Redirects.Upsert(testRedirects(10))
+
+	var upsertErr *RedirectUpsertError
+	if errors.As(err, &upsertErr) || !errors.As(err, new(*APIError)) {
+		t.Errorf("err = %v, want the plain APIError", err)
+	}
+}
@@ -0,0 +1,49 @@
+package bigcommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// RegionService reads the widget regions a theme template file exposes.
+// Regions are defined by the theme, so the API offers no way to modify them.
+type RegionService interface {
+	List(string) ([]Region, error)
+	ListWithContext(context.Context, string) ([]Region, error)
+}
+
+type ListRegionResponse struct {
+	Data []Region `json:"data"`
+}
+
+// Region structure.
+type Region struct {
+	Name string `json:"name"`
+}
+
+type RegionServiceOp struct {
+	client *Client
+}
+
+// List will return the regions in the given template file, e.g. "pages/home".
+func (s *RegionServiceOp) List(templateFile string) ([]Region, error) {
+	return s.ListWithContext(context.Background(), templateFile)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *RegionServiceOp) ListWithContext(ctx context.Context, templateFile string) ([]Region, error) {
+	listResult := ListRegionResponse{}
+	body, err := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/content/regions?templateFile=%s", url.QueryEscape(templateFile)), nil)
+	if err != nil {
+		return listResult.Data, err
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult.Data, jsonErr
+	}
+	return listResult.Data, nil
+}
@@ -0,0 +1,140 @@
+package bigcommerce
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy controls how the client retries requests that fail with a
+// transient error. A nil policy on the Client disables retries.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+	// MinBackoff is the wait before the first retry.
+	MinBackoff time.Duration
+	// MaxBackoff caps the wait between retries.
+	MaxBackoff time.Duration
+	// Multiplier grows the wait after every attempt.
+	Multiplier float64
+	// Jitter is the fraction (0 to 1) of each wait that is randomised.
+	Jitter float64
+	// RetryableStatusCodes lists the response codes that are retried.
+	RetryableStatusCodes []int
+	// RetryNonIdempotent allows POST and PATCH requests to be retried.
+	RetryNonIdempotent bool
+	// IsRetryableError reports whether a transport error is retried.
+	// When nil, timeouts, connection resets/refusals and unexpected EOFs are.
+	IsRetryableError func(error) bool
+	// Backoff, when set, replaces the exponential backoff curve. It receives
+	// the number of attempts made so far.
+	Backoff func(attempt int) time.Duration
+}
+
+// DefaultRetryPolicy returns the policy used by new clients: up to three
+// attempts of idempotent requests failing with a 502, 503 or 504 status or a
+// transient network error.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:          3,
+		MinBackoff:           500 * time.Millisecond,
+		MaxBackoff:           10 * time.Second,
+		Multiplier:           2,
+		Jitter:               0.2,
+		RetryableStatusCodes: []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout},
+	}
+}
+
+// RetryError is returned when a request still fails after being retried.
+// It wraps the error of the final attempt, so errors.As can reach an *APIError.
+type RetryError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("%s (after %d attempts)", e.Err.Error(), e.Attempts)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// shouldRetry reports whether a request that has been attempted attempt
+// times may be attempted again after failing with statusCode or err.
+func (p *RetryPolicy) shouldRetry(method string, attempt, statusCode int, err error) bool {
+	if p == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+	if !p.RetryNonIdempotent && !isIdempotent(method) {
+		return false
+	}
+
+	if err != nil {
+		if p.IsRetryableError != nil {
+			return p.IsRetryableError(err)
+		}
+		return isTransientError(err)
+	}
+
+	for _, code := range p.RetryableStatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff returns the wait before the next attempt.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	if p.Backoff != nil {
+		return p.Backoff(attempt)
+	}
+
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	wait := float64(p.MinBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && wait > float64(p.MaxBackoff) {
+		wait = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		wait -= wait * p.Jitter * rand.Float64()
+	}
+
+	return time.Duration(wait)
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func isTransientError(err error) bool {
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// withAttempts wraps err in a RetryError when the request was retried.
+func withAttempts(err error, attempts int) error {
+	if attempts <= 1 {
+		return err
+	}
+	return &RetryError{Attempts: attempts, Err: err}
+}
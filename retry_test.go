@@ -0,0 +1,171 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func fastRetryPolicy() *RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.MinBackoff = time.Millisecond
+	policy.MaxBackoff = time.Millisecond
+	return policy
+}
+
+func TestDoRequestRetriesTransientFailures(t *testing.T) {
+	var calls int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"name":"widget"}` {
+			t.Errorf("attempt %d sent body %q", atomic.LoadInt32(&calls)+1, body)
+		}
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}, WithRetryPolicy(fastRetryPolicy()))
+
+	if _, err := client.DoRequest(http.MethodPut, "/v3/widgets/1", strings.NewReader(`{"name":"widget"}`)); err != nil {
+		t.Fatalf("DoRequest: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("calls = %d, want 3", calls)
+	}
+}
+
+func TestDoRequestReportsAttempts(t *testing.T) {
+	var calls int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}, WithRetryPolicy(fastRetryPolicy()))
+
+	_, err := client.DoRequest(http.MethodGet, "/v3/widgets", nil)
+
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) || retryErr.Attempts != 3 {
+		t.Fatalf("err = %v, want a RetryError after 3 attempts", err)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadGateway {
+		t.Errorf("err = %v, want it to wrap the final APIError", err)
+	}
+	if calls != 3 {
+		t.Errorf("calls = %d, want 3", calls)
+	}
+}
+
+func TestDoRequestDoesNotRetryNonIdempotentMethods(t *testing.T) {
+	tests := []struct {
+		name               string
+		retryNonIdempotent bool
+		wantCalls          int32
+	}{
+		{"default policy", false, 1},
+		{"RetryNonIdempotent", true, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int32
+			policy := fastRetryPolicy()
+			policy.RetryNonIdempotent = tt.retryNonIdempotent
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&calls, 1)
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}, WithRetryPolicy(policy))
+
+			if _, err := client.DoRequest(http.MethodPost, "/v3/widgets", bytes.NewReader([]byte(`{}`))); err == nil {
+				t.Fatal("DoRequest succeeded, want an error")
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyShouldRetry(t *testing.T) {
+	policy := DefaultRetryPolicy()
+	tests := []struct {
+		name       string
+		policy     *RetryPolicy
+		method     string
+		attempt    int
+		statusCode int
+		err        error
+		want       bool
+	}{
+		{"nil policy", nil, http.MethodGet, 1, http.StatusServiceUnavailable, nil, false},
+		{"retryable status", policy, http.MethodGet, 1, http.StatusServiceUnavailable, nil, true},
+		{"other status", policy, http.MethodGet, 1, http.StatusInternalServerError, nil, false},
+		{"attempts exhausted", policy, http.MethodGet, 3, http.StatusServiceUnavailable, nil, false},
+		{"non-idempotent method", policy, http.MethodPost, 1, http.StatusServiceUnavailable, nil, false},
+		{"idempotent delete", policy, http.MethodDelete, 1, http.StatusGatewayTimeout, nil, true},
+		{"unexpected EOF", policy, http.MethodGet, 1, 0, io.ErrUnexpectedEOF, true},
+		{"other error", policy, http.MethodGet, 1, 0, errors.New("boom"), false},
+		{"custom error check", &RetryPolicy{MaxAttempts: 2, IsRetryableError: func(error) bool { return true }}, http.MethodGet, 1, 0, errors.New("boom"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.shouldRetry(tt.method, tt.attempt, tt.statusCode, tt.err); got != tt.want {
+				t.Errorf("shouldRetry() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := &RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second, Multiplier: 2}
+	for attempt, want := range map[int]time.Duration{
+		1: 100 * time.Millisecond,
+		2: 200 * time.Millisecond,
+		3: 400 * time.Millisecond,
+		5: time.Second,
+	} {
+		if got := policy.backoff(attempt); got != want {
+			t.Errorf("backoff(%d) = %s, want %s", attempt, got, want)
+		}
+	}
+
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		if got := policy.backoff(2); got < 100*time.Millisecond || got > 200*time.Millisecond {
+			t.Fatalf("backoff(2) with jitter = %s, want between 100ms and 200ms", got)
+		}
+	}
+
+	policy.Backoff = func(attempt int) time.Duration { return time.Duration(attempt) * time.Minute }
+	if got := policy.backoff(3); got != 3*time.Minute {
+		t.Errorf("backoff(3) with a custom curve = %s, want 3m", got)
+	}
+}
+
+func TestAPIErrorHelpersSeeThroughRetryError(t *testing.T) {
+	err := &RetryError{Attempts: 3, Err: &APIError{StatusCode: http.StatusTooManyRequests}}
+	if !IsRateLimited(err) {
+		t.Error("IsRateLimited(RetryError) = false")
+	}
+	if IsNotFound(err) || IsConflict(err) {
+		t.Error("RetryError matched the wrong status")
+	}
+
+	err = &RetryError{Attempts: 2, Err: &APIError{StatusCode: http.StatusNotFound}}
+	if !IsNotFound(err) {
+		t.Error("IsNotFound(RetryError) = false")
+	}
+
+	err = &RetryError{Attempts: 2, Err: &APIError{StatusCode: http.StatusConflict}}
+	if !IsConflict(err) {
+		t.Error("IsConflict(RetryError) = false")
+	}
+}
@@ -0,0 +1,238 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Script kinds, as used by Script.Kind.
+const (
+	ScriptKindSrc       = "src"
+	ScriptKindScriptTag = "script_tag"
+)
+
+// Script load methods, as used by Script.LoadMethod.
+const (
+	ScriptLoadDefault = "default"
+	ScriptLoadAsync   = "async"
+	ScriptLoadDefer   = "defer"
+)
+
+// Script locations, as used by Script.Location.
+const (
+	ScriptLocationHead   = "head"
+	ScriptLocationFooter = "footer"
+)
+
+// Script visibilities, as used by Script.Visibility.
+const (
+	ScriptVisibilityStorefront        = "storefront"
+	ScriptVisibilityAllPages          = "all_pages"
+	ScriptVisibilityCheckout          = "checkout"
+	ScriptVisibilityOrderConfirmation = "order_confirmation"
+)
+
+// Script consent categories, as used by Script.ConsentCategory.
+const (
+	ScriptConsentEssential  = "essential"
+	ScriptConsentFunctional = "functional"
+	ScriptConsentAnalytics  = "analytics"
+	ScriptConsentTargeting  = "targeting"
+)
+
+type ScriptService interface {
+	Get(string) (Script, error)
+	GetWithContext(context.Context, string) (Script, error)
+	List(...ListScriptsOptions) (ListScriptResponse, error)
+	ListWithContext(context.Context, ...ListScriptsOptions) (ListScriptResponse, error)
+	Create(Script) (Script, error)
+	CreateWithContext(context.Context, Script) (Script, error)
+	Update(Script) (Script, error)
+	UpdateWithContext(context.Context, Script) (Script, error)
+	Delete(string) error
+	DeleteWithContext(context.Context, string) error
+	Iterate(context.Context, IteratorOptions, ...ListScriptsOptions) *Iterator
+}
+
+type GetScriptResponse struct {
+	Data Script `json:"data"`
+}
+
+type ListScriptResponse struct {
+	Data []Script   `json:"data"`
+	Meta MetaResult `json:"meta"`
+}
+
+// Script structure.
+// Scripts of ScriptKindSrc load Src; scripts of ScriptKindScriptTag embed HTML.
+type Script struct {
+	UUID            string   `json:"uuid,omitempty"`
+	Name            string   `json:"name,omitempty"`
+	Description     string   `json:"description,omitempty"`
+	Kind            string   `json:"kind,omitempty"`
+	Src             string   `json:"src,omitempty"`
+	HTML            string   `json:"html,omitempty"`
+	IntegrityHashes []string `json:"integrity_hashes,omitempty"`
+	LoadMethod      string   `json:"load_method,omitempty"`
+	Location        string   `json:"location,omitempty"`
+	Visibility      string   `json:"visibility,omitempty"`
+	ConsentCategory string   `json:"consent_category,omitempty"`
+	AutoUninstall   *bool    `json:"auto_uninstall,omitempty"`
+	Enabled         *bool    `json:"enabled,omitempty"`
+	ChannelID       int      `json:"channel_id,omitempty"`
+	APIClientID     string   `json:"api_client_id,omitempty"`
+	DateCreated     string   `json:"date_created,omitempty"`
+	DateModified    string   `json:"date_modified,omitempty"`
+}
+
+// ListScriptsOptions filters the scripts returned by List.
+type ListScriptsOptions struct {
+	ListOptions
+	ChannelIDIn []int  `url:"channel_id:in,omitempty"`
+	Sort        string `url:"sort,omitempty"`
+	Direction   string `url:"direction,omitempty"`
+}
+
+type ScriptServiceOp struct {
+	client *Client
+}
+
+// Get will retrieve a script by the UUID.
+func (s *ScriptServiceOp) Get(uuid string) (Script, error) {
+	return s.GetWithContext(context.Background(), uuid)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *ScriptServiceOp) GetWithContext(ctx context.Context, uuid string) (Script, error) {
+	scriptResponse := GetScriptResponse{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/content/scripts/%s", uuid), nil)
+	if reqErr != nil {
+		return scriptResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &scriptResponse)
+	if jsonErr != nil {
+		return scriptResponse.Data, jsonErr
+	}
+	return scriptResponse.Data, nil
+}
+
+// List will return a page of scripts matching the options.
+func (s *ScriptServiceOp) List(options ...ListScriptsOptions) (ListScriptResponse, error) {
+	return s.ListWithContext(context.Background(), options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *ScriptServiceOp) ListWithContext(ctx context.Context, options ...ListScriptsOptions) (ListScriptResponse, error) {
+	listResult := ListScriptResponse{}
+
+	var listOptions ListScriptsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/content/scripts", listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// Create will create a new script.
+// The fields required on a script are: Name, Kind (with Src or HTML to match), LoadMethod,
+// Location, Visibility and, for stores with cookie consent enabled, ConsentCategory.
+func (s *ScriptServiceOp) Create(script Script) (Script, error) {
+	return s.CreateWithContext(context.Background(), script)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *ScriptServiceOp) CreateWithContext(ctx context.Context, script Script) (Script, error) {
+	scriptResponse := GetScriptResponse{}
+	jsonBody, err := json.Marshal(script)
+	if err != nil {
+		return scriptResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, "/v3/content/scripts", reqBody)
+	if reqErr != nil {
+		return scriptResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &scriptResponse)
+	if jsonErr != nil {
+		return scriptResponse.Data, jsonErr
+	}
+	return scriptResponse.Data, nil
+}
+
+// Update will update an existing script.
+func (s *ScriptServiceOp) Update(script Script) (Script, error) {
+	return s.UpdateWithContext(context.Background(), script)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *ScriptServiceOp) UpdateWithContext(ctx context.Context, script Script) (Script, error) {
+	scriptResponse := GetScriptResponse{}
+	jsonBody, err := json.Marshal(script)
+	if err != nil {
+		return scriptResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/content/scripts/%s", script.UUID), reqBody)
+	if reqErr != nil {
+		return scriptResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &scriptResponse)
+	if jsonErr != nil {
+		return scriptResponse.Data, jsonErr
+	}
+	return scriptResponse.Data, nil
+}
+
+// Delete will delete a script by the UUID.
+func (s *ScriptServiceOp) Delete(uuid string) error {
+	return s.DeleteWithContext(context.Background(), uuid)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *ScriptServiceOp) DeleteWithContext(ctx context.Context, uuid string) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/content/scripts/%s", uuid), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// Iterate will walk every script matching the options, fetching further pages as needed.
+func (s *ScriptServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, options ...ListScriptsOptions) *Iterator {
+	var listOptions ListScriptsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListWithContext(ctx, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
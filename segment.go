@@ -0,0 +1,421 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type SegmentService interface {
+	List(...ListSegmentsOptions) (ListSegmentResponse, error)
+	ListWithContext(context.Context, ...ListSegmentsOptions) (ListSegmentResponse, error)
+	Create([]Segment) ([]Segment, error)
+	CreateWithContext(context.Context, []Segment) ([]Segment, error)
+	Update([]Segment) ([]Segment, error)
+	UpdateWithContext(context.Context, []Segment) ([]Segment, error)
+	Delete([]string) error
+	DeleteWithContext(context.Context, []string) error
+	Iterate(context.Context, IteratorOptions, ...ListSegmentsOptions) *Iterator
+	ListShopperProfiles(string, ...ListOptions) (ListShopperProfileResponse, error)
+	ListShopperProfilesWithContext(context.Context, string, ...ListOptions) (ListShopperProfileResponse, error)
+	AddShopperProfiles(string, []string) ([]ShopperProfile, error)
+	AddShopperProfilesWithContext(context.Context, string, []string) ([]ShopperProfile, error)
+	RemoveShopperProfiles(string, []string) error
+	RemoveShopperProfilesWithContext(context.Context, string, []string) error
+}
+
+type ShopperProfileService interface {
+	List(...ListShopperProfilesOptions) (ListShopperProfileResponse, error)
+	ListWithContext(context.Context, ...ListShopperProfilesOptions) (ListShopperProfileResponse, error)
+	Create([]ShopperProfile) ([]ShopperProfile, error)
+	CreateWithContext(context.Context, []ShopperProfile) ([]ShopperProfile, error)
+	Delete([]string) error
+	DeleteWithContext(context.Context, []string) error
+	Iterate(context.Context, IteratorOptions, ...ListShopperProfilesOptions) *Iterator
+	ListSegments(string, ...ListOptions) (ListSegmentResponse, error)
+	ListSegmentsWithContext(context.Context, string, ...ListOptions) (ListSegmentResponse, error)
+}
+
+type ListSegmentResponse struct {
+	Data []Segment  `json:"data"`
+	Meta MetaResult `json:"meta"`
+}
+
+type ListShopperProfileResponse struct {
+	Data []ShopperProfile `json:"data"`
+	Meta MetaResult       `json:"meta"`
+}
+
+// Segment is a named group of shopper profiles.
+type Segment struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+}
+
+// ShopperProfile links a customer to the segments they belong to.
+type ShopperProfile struct {
+	ID         string `json:"id,omitempty"`
+	CustomerID int    `json:"customer_id,omitempty"`
+	CreatedAt  string `json:"created_at,omitempty"`
+	UpdatedAt  string `json:"updated_at,omitempty"`
+}
+
+// ListSegmentsOptions filters the segments returned by List.
+type ListSegmentsOptions struct {
+	ListOptions
+	IDIn []string `url:"id:in,omitempty"`
+}
+
+// ListShopperProfilesOptions filters the shopper profiles returned by List.
+type ListShopperProfilesOptions struct {
+	ListOptions
+	IDIn []string `url:"id:in,omitempty"`
+}
+
+type SegmentServiceOp struct {
+	client *Client
+}
+
+type ShopperProfileServiceOp struct {
+	client *Client
+}
+
+// List will return a page of segments matching the options.
+func (s *SegmentServiceOp) List(options ...ListSegmentsOptions) (ListSegmentResponse, error) {
+	return s.ListWithContext(context.Background(), options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *SegmentServiceOp) ListWithContext(ctx context.Context, options ...ListSegmentsOptions) (ListSegmentResponse, error) {
+	listResult := ListSegmentResponse{}
+
+	var listOptions ListSegmentsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/segments", listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// Create will create the provided segments.
+func (s *SegmentServiceOp) Create(segments []Segment) ([]Segment, error) {
+	return s.CreateWithContext(context.Background(), segments)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *SegmentServiceOp) CreateWithContext(ctx context.Context, segments []Segment) ([]Segment, error) {
+	listResult := ListSegmentResponse{}
+	jsonBody, err := json.Marshal(segments)
+	if err != nil {
+		return listResult.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, "/v3/segments", reqBody)
+	if reqErr != nil {
+		return listResult.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult.Data, jsonErr
+	}
+	return listResult.Data, nil
+}
+
+// Update will update the provided segments.
+// Every segment must have its ID set.
+func (s *SegmentServiceOp) Update(segments []Segment) ([]Segment, error) {
+	return s.UpdateWithContext(context.Background(), segments)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *SegmentServiceOp) UpdateWithContext(ctx context.Context, segments []Segment) ([]Segment, error) {
+	listResult := ListSegmentResponse{}
+	jsonBody, err := json.Marshal(segments)
+	if err != nil {
+		return listResult.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, "/v3/segments", reqBody)
+	if reqErr != nil {
+		return listResult.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult.Data, jsonErr
+	}
+	return listResult.Data, nil
+}
+
+// Delete will delete every segment with one of the provided IDs.
+func (s *SegmentServiceOp) Delete(ids []string) error {
+	return s.DeleteWithContext(context.Background(), ids)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *SegmentServiceOp) DeleteWithContext(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	path, err := addQuery("/v3/segments", ListSegmentsOptions{IDIn: ids})
+	if err != nil {
+		return err
+	}
+
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, path, nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// Iterate will walk every segment matching the options, fetching further pages as needed.
+func (s *SegmentServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, options ...ListSegmentsOptions) *Iterator {
+	var listOptions ListSegmentsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListWithContext(ctx, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
+
+// ListShopperProfiles will return a page of the shopper profiles in a segment.
+func (s *SegmentServiceOp) ListShopperProfiles(segmentID string, options ...ListOptions) (ListShopperProfileResponse, error) {
+	return s.ListShopperProfilesWithContext(context.Background(), segmentID, options...)
+}
+
+// ListShopperProfilesWithContext is the context-aware variant of ListShopperProfiles.
+func (s *SegmentServiceOp) ListShopperProfilesWithContext(ctx context.Context, segmentID string, options ...ListOptions) (ListShopperProfileResponse, error) {
+	listResult := ListShopperProfileResponse{}
+
+	var listOptions ListOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/segments/%s/shopper-profiles", segmentID), listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// AddShopperProfiles will add the shopper profiles with the provided IDs to a segment.
+func (s *SegmentServiceOp) AddShopperProfiles(segmentID string, profileIDs []string) ([]ShopperProfile, error) {
+	return s.AddShopperProfilesWithContext(context.Background(), segmentID, profileIDs)
+}
+
+// AddShopperProfilesWithContext is the context-aware variant of AddShopperProfiles.
+func (s *SegmentServiceOp) AddShopperProfilesWithContext(ctx context.Context, segmentID string, profileIDs []string) ([]ShopperProfile, error) {
+	listResult := ListShopperProfileResponse{}
+	jsonBody, err := json.Marshal(profileIDs)
+	if err != nil {
+		return listResult.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("/v3/segments/%s/shopper-profiles", segmentID), reqBody)
+	if reqErr != nil {
+		return listResult.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult.Data, jsonErr
+	}
+	return listResult.Data, nil
+}
+
+// RemoveShopperProfiles will remove the shopper profiles with the provided IDs from a segment.
+func (s *SegmentServiceOp) RemoveShopperProfiles(segmentID string, profileIDs []string) error {
+	return s.RemoveShopperProfilesWithContext(context.Background(), segmentID, profileIDs)
+}
+
+// RemoveShopperProfilesWithContext is the context-aware variant of RemoveShopperProfiles.
+func (s *SegmentServiceOp) RemoveShopperProfilesWithContext(ctx context.Context, segmentID string, profileIDs []string) error {
+	if len(profileIDs) == 0 {
+		return nil
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/segments/%s/shopper-profiles", segmentID), ListShopperProfilesOptions{IDIn: profileIDs})
+	if err != nil {
+		return err
+	}
+
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, path, nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// List will return a page of shopper profiles matching the options.
+func (s *ShopperProfileServiceOp) List(options ...ListShopperProfilesOptions) (ListShopperProfileResponse, error) {
+	return s.ListWithContext(context.Background(), options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *ShopperProfileServiceOp) ListWithContext(ctx context.Context, options ...ListShopperProfilesOptions) (ListShopperProfileResponse, error) {
+	listResult := ListShopperProfileResponse{}
+
+	var listOptions ListShopperProfilesOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/shopper-profiles", listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// Create will create the provided shopper profiles.
+// The only field required on a shopper profile is: CustomerID
+func (s *ShopperProfileServiceOp) Create(profiles []ShopperProfile) ([]ShopperProfile, error) {
+	return s.CreateWithContext(context.Background(), profiles)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *ShopperProfileServiceOp) CreateWithContext(ctx context.Context, profiles []ShopperProfile) ([]ShopperProfile, error) {
+	listResult := ListShopperProfileResponse{}
+	jsonBody, err := json.Marshal(profiles)
+	if err != nil {
+		return listResult.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, "/v3/shopper-profiles", reqBody)
+	if reqErr != nil {
+		return listResult.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult.Data, jsonErr
+	}
+	return listResult.Data, nil
+}
+
+// Delete will delete every shopper profile with one of the provided IDs.
+func (s *ShopperProfileServiceOp) Delete(ids []string) error {
+	return s.DeleteWithContext(context.Background(), ids)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *ShopperProfileServiceOp) DeleteWithContext(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	path, err := addQuery("/v3/shopper-profiles", ListShopperProfilesOptions{IDIn: ids})
+	if err != nil {
+		return err
+	}
+
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, path, nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// Iterate will walk every shopper profile matching the options, fetching further pages as needed.
+func (s *ShopperProfileServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, options ...ListShopperProfilesOptions) *Iterator {
+	var listOptions ListShopperProfilesOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListWithContext(ctx, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
+
+// ListSegments will return a page of the segments a shopper profile belongs to.
+func (s *ShopperProfileServiceOp) ListSegments(profileID string, options ...ListOptions) (ListSegmentResponse, error) {
+	return s.ListSegmentsWithContext(context.Background(), profileID, options...)
+}
+
+// ListSegmentsWithContext is the context-aware variant of ListSegments.
+func (s *ShopperProfileServiceOp) ListSegmentsWithContext(ctx context.Context, profileID string, options ...ListOptions) (ListSegmentResponse, error) {
+	listResult := ListSegmentResponse{}
+
+	var listOptions ListOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/shopper-profiles/%s/segments", profileID), listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
@@ -0,0 +1,479 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Site route types, as used by SiteRoute.Type.
+const (
+	SiteRouteProduct  = "product"
+	SiteRouteBrand    = "brand"
+	SiteRouteCategory = "category"
+	SiteRoutePage     = "page"
+	SiteRouteBlog     = "blog"
+	SiteRouteHome     = "home"
+	SiteRouteCart     = "cart"
+	SiteRouteCheckout = "checkout"
+	SiteRouteSearch   = "search"
+	SiteRouteAccount  = "account"
+	SiteRouteLogin    = "login"
+	SiteRouteReturns  = "returns"
+	SiteRouteStatic   = "static"
+)
+
+type SiteService interface {
+	Get(int) (Site, error)
+	GetWithContext(context.Context, int) (Site, error)
+	List(...ListSitesOptions) (ListSiteResponse, error)
+	ListWithContext(context.Context, ...ListSitesOptions) (ListSiteResponse, error)
+	Create(Site) (Site, error)
+	CreateWithContext(context.Context, Site) (Site, error)
+	Update(Site) (Site, error)
+	UpdateWithContext(context.Context, Site) (Site, error)
+	Delete(int) error
+	DeleteWithContext(context.Context, int) error
+	Iterate(context.Context, IteratorOptions, ...ListSitesOptions) *Iterator
+	GetRoute(int, int) (SiteRoute, error)
+	GetRouteWithContext(context.Context, int, int) (SiteRoute, error)
+	ListRoutes(int, ...ListSiteRoutesOptions) (ListSiteRouteResponse, error)
+	ListRoutesWithContext(context.Context, int, ...ListSiteRoutesOptions) (ListSiteRouteResponse, error)
+	CreateRoute(int, SiteRoute) (SiteRoute, error)
+	CreateRouteWithContext(context.Context, int, SiteRoute) (SiteRoute, error)
+	UpdateRoute(int, SiteRoute) (SiteRoute, error)
+	UpdateRouteWithContext(context.Context, int, SiteRoute) (SiteRoute, error)
+	UpdateRoutes(int, []SiteRoute) ([]SiteRoute, error)
+	UpdateRoutesWithContext(context.Context, int, []SiteRoute) ([]SiteRoute, error)
+	DeleteRoute(int, int) error
+	DeleteRouteWithContext(context.Context, int, int) error
+	GetCertificate(int) (SiteCertificate, error)
+	GetCertificateWithContext(context.Context, int) (SiteCertificate, error)
+	UpdateCertificate(int, SiteCertificate) (SiteCertificate, error)
+	UpdateCertificateWithContext(context.Context, int, SiteCertificate) (SiteCertificate, error)
+	DeleteCertificate(int) error
+	DeleteCertificateWithContext(context.Context, int) error
+}
+
+type GetSiteResponse struct {
+	Data Site `json:"data"`
+}
+
+type ListSiteResponse struct {
+	Data []Site     `json:"data"`
+	Meta MetaResult `json:"meta"`
+}
+
+type GetSiteRouteResponse struct {
+	Data SiteRoute `json:"data"`
+}
+
+type ListSiteRouteResponse struct {
+	Data []SiteRoute `json:"data"`
+	Meta MetaResult  `json:"meta"`
+}
+
+type GetSiteCertificateResponse struct {
+	Data SiteCertificate `json:"data"`
+}
+
+// Site is the domain a channel's storefront is served on.
+type Site struct {
+	ID        int       `json:"id,omitempty"`
+	URL       string    `json:"url,omitempty"`
+	ChannelID int       `json:"channel_id,omitempty"`
+	SSLStatus string    `json:"ssl_status,omitempty"`
+	URLs      []SiteURL `json:"urls,omitempty"`
+	CreatedAt string    `json:"created_at,omitempty"`
+	UpdatedAt string    `json:"updated_at,omitempty"`
+}
+
+// SiteURL is one of the URLs of a site, such as its primary or checkout URL.
+type SiteURL struct {
+	URL       string `json:"url"`
+	Type      string `json:"type"`
+	CreatedAt string `json:"created_at,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// SiteRoute maps a storefront resource to a URL template on a site.
+// Matching is the ID of the resource, or "*" for every resource of the type,
+// and Route is the template, e.g. "/products/{slug}".
+type SiteRoute struct {
+	ID       int    `json:"id,omitempty"`
+	Type     string `json:"type"`
+	Matching string `json:"matching"`
+	Route    string `json:"route"`
+}
+
+// SiteCertificate is the SSL certificate of a site.
+// Certificate, PrivateKey and IntermediateCertificates are PEM encoded and
+// only used when installing a certificate.
+type SiteCertificate struct {
+	CommonName               string   `json:"common_name,omitempty"`
+	SubjectAlternativeNames  []string `json:"subject_alternative_names,omitempty"`
+	ValidationStatus         string   `json:"validation_status,omitempty"`
+	ValidityNotBefore        string   `json:"validity_not_before,omitempty"`
+	ValidityNotAfter         string   `json:"validity_not_after,omitempty"`
+	Certificate              string   `json:"certificate,omitempty"`
+	PrivateKey               string   `json:"private_key,omitempty"`
+	IntermediateCertificates string   `json:"intermediate_certificates,omitempty"`
+}
+
+// ListSitesOptions filters the sites returned by List.
+type ListSitesOptions struct {
+	ListOptions
+	ChannelID   int    `url:"channel_id,omitempty"`
+	ChannelIDIn []int  `url:"channel_id:in,omitempty"`
+	URLType     string `url:"url_type,omitempty"`
+}
+
+// ListSiteRoutesOptions filters the routes returned by ListRoutes.
+type ListSiteRoutesOptions struct {
+	ListOptions
+	Type     string `url:"type,omitempty"`
+	Matching string `url:"matching,omitempty"`
+}
+
+type SiteServiceOp struct {
+	client *Client
+}
+
+// Get will fetch a single site by the provided ID.
+func (s *SiteServiceOp) Get(id int) (Site, error) {
+	return s.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *SiteServiceOp) GetWithContext(ctx context.Context, id int) (Site, error) {
+	siteResponse := GetSiteResponse{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/sites/%d", id), nil)
+	if reqErr != nil {
+		return siteResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &siteResponse)
+	if jsonErr != nil {
+		return siteResponse.Data, jsonErr
+	}
+	return siteResponse.Data, nil
+}
+
+// List will return a page of sites matching the options.
+func (s *SiteServiceOp) List(options ...ListSitesOptions) (ListSiteResponse, error) {
+	return s.ListWithContext(context.Background(), options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *SiteServiceOp) ListWithContext(ctx context.Context, options ...ListSitesOptions) (ListSiteResponse, error) {
+	listResult := ListSiteResponse{}
+
+	var listOptions ListSitesOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/sites", listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// Create will create a new site.
+// The fields required on a site are: URL and ChannelID.
+func (s *SiteServiceOp) Create(site Site) (Site, error) {
+	return s.CreateWithContext(context.Background(), site)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *SiteServiceOp) CreateWithContext(ctx context.Context, site Site) (Site, error) {
+	siteResponse := GetSiteResponse{}
+	jsonBody, err := json.Marshal(site)
+	if err != nil {
+		return siteResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, "/v3/sites", reqBody)
+	if reqErr != nil {
+		return siteResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &siteResponse)
+	if jsonErr != nil {
+		return siteResponse.Data, jsonErr
+	}
+	return siteResponse.Data, nil
+}
+
+// Update will update the URL of an existing site.
+func (s *SiteServiceOp) Update(site Site) (Site, error) {
+	return s.UpdateWithContext(context.Background(), site)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *SiteServiceOp) UpdateWithContext(ctx context.Context, site Site) (Site, error) {
+	siteResponse := GetSiteResponse{}
+	jsonBody, err := json.Marshal(site)
+	if err != nil {
+		return siteResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/sites/%d", site.ID), reqBody)
+	if reqErr != nil {
+		return siteResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &siteResponse)
+	if jsonErr != nil {
+		return siteResponse.Data, jsonErr
+	}
+	return siteResponse.Data, nil
+}
+
+// Delete will delete a site by the provided ID.
+func (s *SiteServiceOp) Delete(id int) error {
+	return s.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *SiteServiceOp) DeleteWithContext(ctx context.Context, id int) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/sites/%d", id), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// Iterate will walk every site matching the options, fetching further pages as needed.
+func (s *SiteServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, options ...ListSitesOptions) *Iterator {
+	var listOptions ListSitesOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListWithContext(ctx, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
+
+// GetRoute will fetch a single route of a site.
+func (s *SiteServiceOp) GetRoute(siteID, routeID int) (SiteRoute, error) {
+	return s.GetRouteWithContext(context.Background(), siteID, routeID)
+}
+
+// GetRouteWithContext is the context-aware variant of GetRoute.
+func (s *SiteServiceOp) GetRouteWithContext(ctx context.Context, siteID, routeID int) (SiteRoute, error) {
+	routeResponse := GetSiteRouteResponse{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/sites/%d/routes/%d", siteID, routeID), nil)
+	if reqErr != nil {
+		return routeResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &routeResponse)
+	if jsonErr != nil {
+		return routeResponse.Data, jsonErr
+	}
+	return routeResponse.Data, nil
+}
+
+// ListRoutes will return a page of the routes of a site matching the options.
+func (s *SiteServiceOp) ListRoutes(siteID int, options ...ListSiteRoutesOptions) (ListSiteRouteResponse, error) {
+	return s.ListRoutesWithContext(context.Background(), siteID, options...)
+}
+
+// ListRoutesWithContext is the context-aware variant of ListRoutes.
+func (s *SiteServiceOp) ListRoutesWithContext(ctx context.Context, siteID int, options ...ListSiteRoutesOptions) (ListSiteRouteResponse, error) {
+	listResult := ListSiteRouteResponse{}
+
+	var listOptions ListSiteRoutesOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery(fmt.Sprintf("/v3/sites/%d/routes", siteID), listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// CreateRoute will create a route on a site.
+// The fields required on a route are: Type, Matching and Route.
+func (s *SiteServiceOp) CreateRoute(siteID int, route SiteRoute) (SiteRoute, error) {
+	return s.CreateRouteWithContext(context.Background(), siteID, route)
+}
+
+// CreateRouteWithContext is the context-aware variant of CreateRoute.
+func (s *SiteServiceOp) CreateRouteWithContext(ctx context.Context, siteID int, route SiteRoute) (SiteRoute, error) {
+	routeResponse := GetSiteRouteResponse{}
+	jsonBody, err := json.Marshal(route)
+	if err != nil {
+		return routeResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("/v3/sites/%d/routes", siteID), reqBody)
+	if reqErr != nil {
+		return routeResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &routeResponse)
+	if jsonErr != nil {
+		return routeResponse.Data, jsonErr
+	}
+	return routeResponse.Data, nil
+}
+
+// UpdateRoute will update a route of a site.
+func (s *SiteServiceOp) UpdateRoute(siteID int, route SiteRoute) (SiteRoute, error) {
+	return s.UpdateRouteWithContext(context.Background(), siteID, route)
+}
+
+// UpdateRouteWithContext is the context-aware variant of UpdateRoute.
+func (s *SiteServiceOp) UpdateRouteWithContext(ctx context.Context, siteID int, route SiteRoute) (SiteRoute, error) {
+	routeResponse := GetSiteRouteResponse{}
+	jsonBody, err := json.Marshal(route)
+	if err != nil {
+		return routeResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/sites/%d/routes/%d", siteID, route.ID), reqBody)
+	if reqErr != nil {
+		return routeResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &routeResponse)
+	if jsonErr != nil {
+		return routeResponse.Data, jsonErr
+	}
+	return routeResponse.Data, nil
+}
+
+// UpdateRoutes will create or update several routes of a site in a single request.
+func (s *SiteServiceOp) UpdateRoutes(siteID int, routes []SiteRoute) ([]SiteRoute, error) {
+	return s.UpdateRoutesWithContext(context.Background(), siteID, routes)
+}
+
+// UpdateRoutesWithContext is the context-aware variant of UpdateRoutes.
+func (s *SiteServiceOp) UpdateRoutesWithContext(ctx context.Context, siteID int, routes []SiteRoute) ([]SiteRoute, error) {
+	listResult := ListSiteRouteResponse{}
+	jsonBody, err := json.Marshal(routes)
+	if err != nil {
+		return listResult.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/sites/%d/routes", siteID), reqBody)
+	if reqErr != nil {
+		return listResult.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult.Data, jsonErr
+	}
+	return listResult.Data, nil
+}
+
+// DeleteRoute will delete a route of a site.
+func (s *SiteServiceOp) DeleteRoute(siteID, routeID int) error {
+	return s.DeleteRouteWithContext(context.Background(), siteID, routeID)
+}
+
+// DeleteRouteWithContext is the context-aware variant of DeleteRoute.
+func (s *SiteServiceOp) DeleteRouteWithContext(ctx context.Context, siteID, routeID int) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/sites/%d/routes/%d", siteID, routeID), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// GetCertificate will fetch the SSL certificate installed on a site.
+func (s *SiteServiceOp) GetCertificate(siteID int) (SiteCertificate, error) {
+	return s.GetCertificateWithContext(context.Background(), siteID)
+}
+
+// GetCertificateWithContext is the context-aware variant of GetCertificate.
+func (s *SiteServiceOp) GetCertificateWithContext(ctx context.Context, siteID int) (SiteCertificate, error) {
+	certificateResponse := GetSiteCertificateResponse{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/sites/%d/certificate", siteID), nil)
+	if reqErr != nil {
+		return certificateResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &certificateResponse)
+	if jsonErr != nil {
+		return certificateResponse.Data, jsonErr
+	}
+	return certificateResponse.Data, nil
+}
+
+// UpdateCertificate will install an SSL certificate on a site, replacing any existing one.
+// The fields required on a certificate are: Certificate and PrivateKey.
+func (s *SiteServiceOp) UpdateCertificate(siteID int, certificate SiteCertificate) (SiteCertificate, error) {
+	return s.UpdateCertificateWithContext(context.Background(), siteID, certificate)
+}
+
+// UpdateCertificateWithContext is the context-aware variant of UpdateCertificate.
+func (s *SiteServiceOp) UpdateCertificateWithContext(ctx context.Context, siteID int, certificate SiteCertificate) (SiteCertificate, error) {
+	certificateResponse := GetSiteCertificateResponse{}
+	jsonBody, err := json.Marshal(certificate)
+	if err != nil {
+		return certificateResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/sites/%d/certificate", siteID), reqBody)
+	if reqErr != nil {
+		return certificateResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &certificateResponse)
+	if jsonErr != nil {
+		return certificateResponse.Data, jsonErr
+	}
+	return certificateResponse.Data, nil
+}
+
+// DeleteCertificate will remove the SSL certificate of a site.
+func (s *SiteServiceOp) DeleteCertificate(siteID int) error {
+	return s.DeleteCertificateWithContext(context.Background(), siteID)
+}
+
+// DeleteCertificateWithContext is the context-aware variant of DeleteCertificate.
+func (s *SiteServiceOp) DeleteCertificateWithContext(ctx context.Context, siteID int) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/sites/%d/certificate", siteID), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
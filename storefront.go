@@ -7,4 +7,5 @@ type StorefrontService struct {
 	Search    StorefrontSearchSettingsService
 	Category  StorefrontCategorySettingsService
 	RobotsTxt StorefrontRobotsTxtSettingsService
+	Redirects RedirectService
 }
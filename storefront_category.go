@@ -2,6 +2,7 @@ package bigcommerce
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -10,8 +11,11 @@ import (
 
 type StorefrontCategorySettingsService interface {
 	Get(...int) (StorefrontCategorySettings, error)
+	GetWithContext(context.Context, ...int) (StorefrontCategorySettings, error)
 	Update(StorefrontCategorySettings, ...int) (StorefrontCategorySettings, error)
+	UpdateWithContext(context.Context, StorefrontCategorySettings, ...int) (StorefrontCategorySettings, error)
 	Delete(int, []string) error
+	DeleteWithContext(context.Context, int, []string) error
 }
 
 type StorefrontCategorySettings struct {
@@ -28,6 +32,10 @@ type StorefrontCategorySettingsOp struct {
 }
 
 func (s *StorefrontCategorySettingsOp) Get(channelID ...int) (StorefrontCategorySettings, error) {
+	return s.GetWithContext(context.Background(), channelID...)
+}
+
+func (s *StorefrontCategorySettingsOp) GetWithContext(ctx context.Context, channelID ...int) (StorefrontCategorySettings, error) {
 	var categoryResponse StorefrontCategorySettingsResponse
 
 	var queryString string
@@ -35,7 +43,7 @@ func (s *StorefrontCategorySettingsOp) Get(channelID ...int) (StorefrontCategory
 		queryString = fmt.Sprintf("?channel_id=%d", channelID[0])
 	}
 
-	body, reqErr := s.client.DoRequest(http.MethodGet, fmt.Sprintf("/v3/settings/storefront/category%s", queryString), nil)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/settings/storefront/category%s", queryString), nil)
 	if reqErr != nil {
 		return categoryResponse.Data, reqErr
 	}
@@ -49,6 +57,10 @@ func (s *StorefrontCategorySettingsOp) Get(channelID ...int) (StorefrontCategory
 }
 
 func (s *StorefrontCategorySettingsOp) Update(category StorefrontCategorySettings, channelID ...int) (StorefrontCategorySettings, error) {
+	return s.UpdateWithContext(context.Background(), category, channelID...)
+}
+
+func (s *StorefrontCategorySettingsOp) UpdateWithContext(ctx context.Context, category StorefrontCategorySettings, channelID ...int) (StorefrontCategorySettings, error) {
 	var categoryResponse StorefrontCategorySettingsResponse
 
 	var queryString string
@@ -62,7 +74,7 @@ func (s *StorefrontCategorySettingsOp) Update(category StorefrontCategorySetting
 	}
 
 	reqBody := bytes.NewReader(jsonBody)
-	body, reqErr := s.client.DoRequest(http.MethodPut, fmt.Sprintf("/v3/settings/storefront/category%s", queryString), reqBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/settings/storefront/category%s", queryString), reqBody)
 	if reqErr != nil {
 		return categoryResponse.Data, reqErr
 	}
@@ -75,8 +87,12 @@ func (s *StorefrontCategorySettingsOp) Update(category StorefrontCategorySetting
 }
 
 func (s *StorefrontCategorySettingsOp) Delete(channelID int, keys []string) error {
+	return s.DeleteWithContext(context.Background(), channelID, keys)
+}
+
+func (s *StorefrontCategorySettingsOp) DeleteWithContext(ctx context.Context, channelID int, keys []string) error {
 	keysCsv := strings.Join(keys[:], ",")
-	_, reqErr := s.client.DoRequest(http.MethodDelete, fmt.Sprintf("/v3/settings/storefront/category?channel_id=%d&keys=%s", channelID, keysCsv), nil)
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/settings/storefront/category?channel_id=%d&keys=%s", channelID, keysCsv), nil)
 	if reqErr != nil {
 		return reqErr
 	}
@@ -2,6 +2,7 @@ package bigcommerce
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -10,8 +11,11 @@ import (
 
 type StorefrontRobotsTxtSettingsService interface {
 	Get(...int) (StorefrontRobotsTxtSettings, error)
+	GetWithContext(context.Context, ...int) (StorefrontRobotsTxtSettings, error)
 	Update(StorefrontRobotsTxtSettings, ...int) (StorefrontRobotsTxtSettings, error)
+	UpdateWithContext(context.Context, StorefrontRobotsTxtSettings, ...int) (StorefrontRobotsTxtSettings, error)
 	Delete(int, []string) error
+	DeleteWithContext(context.Context, int, []string) error
 }
 
 type StorefrontRobotsTxtSettings struct {
@@ -27,6 +31,10 @@ type StorefrontRobotsTxtSettingsOp struct {
 }
 
 func (s *StorefrontRobotsTxtSettingsOp) Get(channelID ...int) (StorefrontRobotsTxtSettings, error) {
+	return s.GetWithContext(context.Background(), channelID...)
+}
+
+func (s *StorefrontRobotsTxtSettingsOp) GetWithContext(ctx context.Context, channelID ...int) (StorefrontRobotsTxtSettings, error) {
 	var robotsResponse StorefrontRobotsTxtSettingsResponse
 
 	var queryString string
@@ -34,7 +42,7 @@ func (s *StorefrontRobotsTxtSettingsOp) Get(channelID ...int) (StorefrontRobotsT
 		queryString = fmt.Sprintf("?channel_id=%d", channelID[0])
 	}
 
-	body, reqErr := s.client.DoRequest(http.MethodGet, fmt.Sprintf("/v3/settings/storefront/robotstxt%s", queryString), nil)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/settings/storefront/robotstxt%s", queryString), nil)
 	if reqErr != nil {
 		return robotsResponse.Data, reqErr
 	}
@@ -48,6 +56,10 @@ func (s *StorefrontRobotsTxtSettingsOp) Get(channelID ...int) (StorefrontRobotsT
 }
 
 func (s *StorefrontRobotsTxtSettingsOp) Update(robots StorefrontRobotsTxtSettings, channelID ...int) (StorefrontRobotsTxtSettings, error) {
+	return s.UpdateWithContext(context.Background(), robots, channelID...)
+}
+
+func (s *StorefrontRobotsTxtSettingsOp) UpdateWithContext(ctx context.Context, robots StorefrontRobotsTxtSettings, channelID ...int) (StorefrontRobotsTxtSettings, error) {
 	var robotsResponse StorefrontRobotsTxtSettingsResponse
 
 	var queryString string
@@ -61,7 +73,7 @@ func (s *StorefrontRobotsTxtSettingsOp) Update(robots StorefrontRobotsTxtSetting
 	}
 
 	reqBody := bytes.NewReader(jsonBody)
-	body, reqErr := s.client.DoRequest(http.MethodPut, fmt.Sprintf("/v3/settings/storefront/robotstxt%s", queryString), reqBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/settings/storefront/robotstxt%s", queryString), reqBody)
 	if reqErr != nil {
 		return robotsResponse.Data, reqErr
 	}
@@ -74,8 +86,12 @@ func (s *StorefrontRobotsTxtSettingsOp) Update(robots StorefrontRobotsTxtSetting
 }
 
 func (s *StorefrontRobotsTxtSettingsOp) Delete(channelID int, keys []string) error {
+	return s.DeleteWithContext(context.Background(), channelID, keys)
+}
+
+func (s *StorefrontRobotsTxtSettingsOp) DeleteWithContext(ctx context.Context, channelID int, keys []string) error {
 	keysCsv := strings.Join(keys[:], ",")
-	_, reqErr := s.client.DoRequest(http.MethodDelete, fmt.Sprintf("/v3/settings/storefront/robotstxt?channel_id=%d&keys=%s", channelID, keysCsv), nil)
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/settings/storefront/robotstxt?channel_id=%d&keys=%s", channelID, keysCsv), nil)
 	if reqErr != nil {
 		return reqErr
 	}
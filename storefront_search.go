@@ -2,6 +2,7 @@ package bigcommerce
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -10,8 +11,11 @@ import (
 
 type StorefrontSearchSettingsService interface {
 	Get(...int) (StorefrontSearchSettings, error)
+	GetWithContext(context.Context, ...int) (StorefrontSearchSettings, error)
 	Update(StorefrontSearchSettings, ...int) (StorefrontSearchSettings, error)
+	UpdateWithContext(context.Context, StorefrontSearchSettings, ...int) (StorefrontSearchSettings, error)
 	Delete(int, []string) error
+	DeleteWithContext(context.Context, int, []string) error
 }
 
 type StorefrontSearchSettings struct {
@@ -27,6 +31,10 @@ type StorefrontSearchSettingsOp struct {
 }
 
 func (s *StorefrontSearchSettingsOp) Get(channelID ...int) (StorefrontSearchSettings, error) {
+	return s.GetWithContext(context.Background(), channelID...)
+}
+
+func (s *StorefrontSearchSettingsOp) GetWithContext(ctx context.Context, channelID ...int) (StorefrontSearchSettings, error) {
 	var searchResponse StorefrontSearchSettingsResponse
 
 	var queryString string
@@ -34,7 +42,7 @@ func (s *StorefrontSearchSettingsOp) Get(channelID ...int) (StorefrontSearchSett
 		queryString = fmt.Sprintf("?channel_id=%d", channelID[0])
 	}
 
-	body, reqErr := s.client.DoRequest(http.MethodGet, fmt.Sprintf("/v3/settings/storefront/search%s", queryString), nil)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/settings/storefront/search%s", queryString), nil)
 	if reqErr != nil {
 		return searchResponse.Data, reqErr
 	}
@@ -48,6 +56,10 @@ func (s *StorefrontSearchSettingsOp) Get(channelID ...int) (StorefrontSearchSett
 }
 
 func (s *StorefrontSearchSettingsOp) Update(search StorefrontSearchSettings, channelID ...int) (StorefrontSearchSettings, error) {
+	return s.UpdateWithContext(context.Background(), search, channelID...)
+}
+
+func (s *StorefrontSearchSettingsOp) UpdateWithContext(ctx context.Context, search StorefrontSearchSettings, channelID ...int) (StorefrontSearchSettings, error) {
 	var searchResponse StorefrontSearchSettingsResponse
 
 	var queryString string
@@ -61,7 +73,7 @@ func (s *StorefrontSearchSettingsOp) Update(search StorefrontSearchSettings, cha
 	}
 
 	reqBody := bytes.NewReader(jsonBody)
-	body, reqErr := s.client.DoRequest(http.MethodPut, fmt.Sprintf("/v3/settings/storefront/search%s", queryString), reqBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/settings/storefront/search%s", queryString), reqBody)
 	if reqErr != nil {
 		return searchResponse.Data, reqErr
 	}
@@ -74,8 +86,12 @@ func (s *StorefrontSearchSettingsOp) Update(search StorefrontSearchSettings, cha
 }
 
 func (s *StorefrontSearchSettingsOp) Delete(channelID int, keys []string) error {
+	return s.DeleteWithContext(context.Background(), channelID, keys)
+}
+
+func (s *StorefrontSearchSettingsOp) DeleteWithContext(ctx context.Context, channelID int, keys []string) error {
 	keysCsv := strings.Join(keys[:], ",")
-	_, reqErr := s.client.DoRequest(http.MethodDelete, fmt.Sprintf("/v3/settings/storefront/search?channel_id=%d&keys=%s", channelID, keysCsv), nil)
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/settings/storefront/search?channel_id=%d&keys=%s", channelID, keysCsv), nil)
 	if reqErr != nil {
 		return reqErr
 	}
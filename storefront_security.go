@@ -2,6 +2,7 @@ package bigcommerce
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -10,8 +11,11 @@ import (
 
 type StorefrontSecuritySettingsService interface {
 	Get(...int) (StorefrontSecuritySettings, error)
+	GetWithContext(context.Context, ...int) (StorefrontSecuritySettings, error)
 	Update(StorefrontSecuritySettings, ...int) (StorefrontSecuritySettings, error)
+	UpdateWithContext(context.Context, StorefrontSecuritySettings, ...int) (StorefrontSecuritySettings, error)
 	Delete(int, []string) error
+	DeleteWithContext(context.Context, int, []string) error
 }
 
 type HSTSOptions struct {
@@ -35,6 +39,10 @@ type StorefrontSecuritySettingsOp struct {
 }
 
 func (s *StorefrontSecuritySettingsOp) Get(channelID ...int) (StorefrontSecuritySettings, error) {
+	return s.GetWithContext(context.Background(), channelID...)
+}
+
+func (s *StorefrontSecuritySettingsOp) GetWithContext(ctx context.Context, channelID ...int) (StorefrontSecuritySettings, error) {
 	var securityResponse StorefrontSecuritySettingsResponse
 
 	var queryString string
@@ -42,7 +50,7 @@ func (s *StorefrontSecuritySettingsOp) Get(channelID ...int) (StorefrontSecurity
 		queryString = fmt.Sprintf("?channel_id=%d", channelID[0])
 	}
 
-	body, reqErr := s.client.DoRequest(http.MethodGet, fmt.Sprintf("/v3/settings/storefront/security%s", queryString), nil)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/settings/storefront/security%s", queryString), nil)
 	if reqErr != nil {
 		return securityResponse.Data, reqErr
 	}
@@ -56,6 +64,10 @@ func (s *StorefrontSecuritySettingsOp) Get(channelID ...int) (StorefrontSecurity
 }
 
 func (s *StorefrontSecuritySettingsOp) Update(security StorefrontSecuritySettings, channelID ...int) (StorefrontSecuritySettings, error) {
+	return s.UpdateWithContext(context.Background(), security, channelID...)
+}
+
+func (s *StorefrontSecuritySettingsOp) UpdateWithContext(ctx context.Context, security StorefrontSecuritySettings, channelID ...int) (StorefrontSecuritySettings, error) {
 	var securityResponse StorefrontSecuritySettingsResponse
 
 	var queryString string
@@ -69,7 +81,7 @@ func (s *StorefrontSecuritySettingsOp) Update(security StorefrontSecuritySetting
 	}
 
 	reqBody := bytes.NewReader(jsonBody)
-	body, reqErr := s.client.DoRequest(http.MethodPut, fmt.Sprintf("/v3/settings/storefront/security%s", queryString), reqBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/settings/storefront/security%s", queryString), reqBody)
 	if reqErr != nil {
 		return securityResponse.Data, reqErr
 	}
@@ -82,8 +94,12 @@ func (s *StorefrontSecuritySettingsOp) Update(security StorefrontSecuritySetting
 }
 
 func (s *StorefrontSecuritySettingsOp) Delete(channelID int, keys []string) error {
+	return s.DeleteWithContext(context.Background(), channelID, keys)
+}
+
+func (s *StorefrontSecuritySettingsOp) DeleteWithContext(ctx context.Context, channelID int, keys []string) error {
 	keysCsv := strings.Join(keys[:], ",")
-	_, reqErr := s.client.DoRequest(http.MethodDelete, fmt.Sprintf("/v3/settings/storefront/security?channel_id=%d&keys=%s", channelID, keysCsv), nil)
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/settings/storefront/security?channel_id=%d&keys=%s", channelID, keysCsv), nil)
 	if reqErr != nil {
 		return reqErr
 	}
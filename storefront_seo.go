@@ -2,6 +2,7 @@ package bigcommerce
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -10,8 +11,11 @@ import (
 
 type StorefrontSeoSettingsService interface {
 	Get(...int) (StorefrontSeoSettings, error)
+	GetWithContext(context.Context, ...int) (StorefrontSeoSettings, error)
 	Update(StorefrontSeoSettings, ...int) (StorefrontSeoSettings, error)
+	UpdateWithContext(context.Context, StorefrontSeoSettings, ...int) (StorefrontSeoSettings, error)
 	Delete(int, []string) error
+	DeleteWithContext(context.Context, int, []string) error
 }
 
 type StorefrontSeoSettings struct {
@@ -27,6 +31,10 @@ type StorefrontSeoSettingsOp struct {
 }
 
 func (s *StorefrontSeoSettingsOp) Get(channelID ...int) (StorefrontSeoSettings, error) {
+	return s.GetWithContext(context.Background(), channelID...)
+}
+
+func (s *StorefrontSeoSettingsOp) GetWithContext(ctx context.Context, channelID ...int) (StorefrontSeoSettings, error) {
 	var seoResponse StorefrontSeoSettingsResponse
 
 	var queryString string
@@ -34,7 +42,7 @@ func (s *StorefrontSeoSettingsOp) Get(channelID ...int) (StorefrontSeoSettings,
 		queryString = fmt.Sprintf("?channel_id=%d", channelID[0])
 	}
 
-	body, reqErr := s.client.DoRequest(http.MethodGet, fmt.Sprintf("/v3/settings/storefront/seo%s", queryString), nil)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/settings/storefront/seo%s", queryString), nil)
 	if reqErr != nil {
 		return seoResponse.Data, reqErr
 	}
@@ -48,6 +56,10 @@ func (s *StorefrontSeoSettingsOp) Get(channelID ...int) (StorefrontSeoSettings,
 }
 
 func (s *StorefrontSeoSettingsOp) Update(settings StorefrontSeoSettings, channelID ...int) (StorefrontSeoSettings, error) {
+	return s.UpdateWithContext(context.Background(), settings, channelID...)
+}
+
+func (s *StorefrontSeoSettingsOp) UpdateWithContext(ctx context.Context, settings StorefrontSeoSettings, channelID ...int) (StorefrontSeoSettings, error) {
 	var seoResponse StorefrontSeoSettingsResponse
 
 	var queryString string
@@ -61,7 +73,7 @@ func (s *StorefrontSeoSettingsOp) Update(settings StorefrontSeoSettings, channel
 	}
 
 	reqBody := bytes.NewReader(jsonBody)
-	body, reqErr := s.client.DoRequest(http.MethodPut, fmt.Sprintf("/v3/settings/storefront/seo%s", queryString), reqBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/settings/storefront/seo%s", queryString), reqBody)
 	if reqErr != nil {
 		return seoResponse.Data, reqErr
 	}
@@ -74,8 +86,12 @@ func (s *StorefrontSeoSettingsOp) Update(settings StorefrontSeoSettings, channel
 }
 
 func (s *StorefrontSeoSettingsOp) Delete(channelID int, keys []string) error {
+	return s.DeleteWithContext(context.Background(), channelID, keys)
+}
+
+func (s *StorefrontSeoSettingsOp) DeleteWithContext(ctx context.Context, channelID int, keys []string) error {
 	keysCsv := strings.Join(keys[:], ",")
-	_, reqErr := s.client.DoRequest(http.MethodDelete, fmt.Sprintf("/v3/settings/storefront/seo?channel_id=%d&keys=%s", channelID, keysCsv), nil)
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/settings/storefront/seo?channel_id=%d&keys=%s", channelID, keysCsv), nil)
 	if reqErr != nil {
 		return reqErr
 	}
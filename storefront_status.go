@@ -2,6 +2,7 @@ package bigcommerce
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -10,14 +11,17 @@ import (
 
 type StorefrontStatusService interface {
 	Get(...int) (StorefrontStatus, error)
+	GetWithContext(context.Context, ...int) (StorefrontStatus, error)
 	Update(StorefrontStatus, ...int) (StorefrontStatus, error)
+	UpdateWithContext(context.Context, StorefrontStatus, ...int) (StorefrontStatus, error)
 	Delete(int, []string) error
+	DeleteWithContext(context.Context, int, []string) error
 }
 
 type StorefrontStatus struct {
 	DownForMaintenanceMessage string `json:"down_for_maintenance"`
 	PrelaunchMessage          string `json:"prelaunch_message"`
-	PrelaunchPassword         string `json:"prelaunch_message"`
+	PrelaunchPassword         string `json:"prelaunch_password"`
 }
 
 type StorefrontStatusResponse struct {
@@ -29,6 +33,10 @@ type StorefrontStatusOp struct {
 }
 
 func (s *StorefrontStatusOp) Get(channelID ...int) (StorefrontStatus, error) {
+	return s.GetWithContext(context.Background(), channelID...)
+}
+
+func (s *StorefrontStatusOp) GetWithContext(ctx context.Context, channelID ...int) (StorefrontStatus, error) {
 	var statusResponse StorefrontStatusResponse
 
 	var queryString string
@@ -36,7 +44,7 @@ func (s *StorefrontStatusOp) Get(channelID ...int) (StorefrontStatus, error) {
 		queryString = fmt.Sprintf("?channel_id=%d", channelID[0])
 	}
 
-	body, reqErr := s.client.DoRequest(http.MethodGet, fmt.Sprintf("/v3/settings/storefront/status%s", queryString), nil)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/settings/storefront/status%s", queryString), nil)
 	if reqErr != nil {
 		return statusResponse.Data, reqErr
 	}
@@ -50,6 +58,10 @@ func (s *StorefrontStatusOp) Get(channelID ...int) (StorefrontStatus, error) {
 }
 
 func (s *StorefrontStatusOp) Update(status StorefrontStatus, channelID ...int) (StorefrontStatus, error) {
+	return s.UpdateWithContext(context.Background(), status, channelID...)
+}
+
+func (s *StorefrontStatusOp) UpdateWithContext(ctx context.Context, status StorefrontStatus, channelID ...int) (StorefrontStatus, error) {
 	var statusResponse StorefrontStatusResponse
 
 	var queryString string
@@ -63,7 +75,7 @@ func (s *StorefrontStatusOp) Update(status StorefrontStatus, channelID ...int) (
 	}
 
 	reqBody := bytes.NewReader(jsonBody)
-	body, reqErr := s.client.DoRequest(http.MethodPut, fmt.Sprintf("/v3/settings/storefront/status%s", queryString), reqBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/settings/storefront/status%s", queryString), reqBody)
 	if reqErr != nil {
 		return statusResponse.Data, reqErr
 	}
@@ -77,8 +89,12 @@ func (s *StorefrontStatusOp) Update(status StorefrontStatus, channelID ...int) (
 }
 
 func (s *StorefrontStatusOp) Delete(channelID int, keys []string) error {
+	return s.DeleteWithContext(context.Background(), channelID, keys)
+}
+
+func (s *StorefrontStatusOp) DeleteWithContext(ctx context.Context, channelID int, keys []string) error {
 	keysCsv := strings.Join(keys[:], ",")
-	_, reqErr := s.client.DoRequest(http.MethodDelete, fmt.Sprintf("/v3/settings/storefront/status?channel_id=%d&keys=%s", channelID, keysCsv), nil)
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/settings/storefront/status?channel_id=%d&keys=%s", channelID, keysCsv), nil)
 	if reqErr != nil {
 		return reqErr
 	}
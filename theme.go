@@ -0,0 +1,348 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Theme versions, as used by Download and ThemeActivation.Which.
+const (
+	ThemeVersionOriginal      = "original"
+	ThemeVersionLastActivated = "last_activated"
+	ThemeVersionLastCreated   = "last_created"
+)
+
+// Theme job statuses, as used by ThemeJob.Status.
+const (
+	ThemeJobQueued    = "QUEUED"
+	ThemeJobWorking   = "WORKING"
+	ThemeJobCompleted = "COMPLETED"
+	ThemeJobFailed    = "FAILED"
+)
+
+type ThemeService interface {
+	Get(string) (Theme, error)
+	GetWithContext(context.Context, string) (Theme, error)
+	List() (ListThemeResponse, error)
+	ListWithContext(context.Context) (ListThemeResponse, error)
+	Upload(string, io.Reader) (string, error)
+	UploadWithContext(context.Context, string, io.Reader) (string, error)
+	UploadAndWait(string, io.Reader, ...JobWaitOptions) (string, error)
+	UploadAndWaitWithContext(context.Context, string, io.Reader, ...JobWaitOptions) (string, error)
+	Download(string, string) (string, error)
+	DownloadWithContext(context.Context, string, string) (string, error)
+	DownloadAndWait(string, string, ...JobWaitOptions) (string, error)
+	DownloadAndWaitWithContext(context.Context, string, string, ...JobWaitOptions) (string, error)
+	Activate(ThemeActivation) error
+	ActivateWithContext(context.Context, ThemeActivation) error
+	Delete(string) error
+	DeleteWithContext(context.Context, string) error
+	GetJob(string) (ThemeJob, error)
+	GetJobWithContext(context.Context, string) (ThemeJob, error)
+	WaitForJob(string, ...JobWaitOptions) (ThemeJob, error)
+	WaitForJobWithContext(context.Context, string, ...JobWaitOptions) (ThemeJob, error)
+}
+
+type GetThemeResponse struct {
+	Data Theme `json:"data"`
+}
+
+type ListThemeResponse struct {
+	Data []Theme    `json:"data"`
+	Meta MetaResult `json:"meta"`
+}
+
+type GetThemeJobResponse struct {
+	Data ThemeJob `json:"data"`
+}
+
+// Theme structure.
+type Theme struct {
+	UUID       string           `json:"uuid"`
+	Name       string           `json:"name"`
+	IsPrivate  bool             `json:"is_private"`
+	IsActive   bool             `json:"is_active"`
+	Variations []ThemeVariation `json:"variations"`
+}
+
+// ThemeVariation is a style variation of a theme.
+type ThemeVariation struct {
+	UUID        string `json:"uuid"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	ExternalID  string `json:"external_id"`
+}
+
+// ThemeActivation selects the theme variation to activate. Which defaults
+// to ThemeVersionLastActivated; ChannelID defaults to the default storefront.
+type ThemeActivation struct {
+	VariationID string `json:"variation_id"`
+	Which       string `json:"which,omitempty"`
+	ChannelID   int    `json:"channel_id,omitempty"`
+}
+
+// ThemeJob is an asynchronous theme upload or download.
+type ThemeJob struct {
+	ID              string            `json:"id"`
+	Status          string            `json:"status"`
+	PercentComplete float64           `json:"percent_complete"`
+	Time            string            `json:"time"`
+	Errors          []ThemeJobMessage `json:"errors"`
+	Warnings        []ThemeJobMessage `json:"warnings"`
+	Result          ThemeJobResult    `json:"result"`
+}
+
+// ThemeJobMessage is an error or warning reported by a theme job.
+type ThemeJobMessage struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// ThemeJobResult is the outcome of a completed theme job: the UUID of an
+// uploaded theme, or the URL of a downloadable theme.
+type ThemeJobResult struct {
+	ThemeID     string `json:"theme_id"`
+	DownloadURL string `json:"download_url"`
+}
+
+// ThemeJobError is returned when a theme job fails.
+type ThemeJobError struct {
+	Job ThemeJob
+}
+
+func (e *ThemeJobError) Error() string {
+	msg := fmt.Sprintf("bigcommerce: theme job %s failed", e.Job.ID)
+	if len(e.Job.Errors) > 0 {
+		messages := make([]string, 0, len(e.Job.Errors))
+		for _, message := range e.Job.Errors {
+			messages = append(messages, message.Message)
+		}
+		msg += ": " + strings.Join(messages, "; ")
+	}
+	return msg
+}
+
+type themeJobIDResponse struct {
+	JobID string `json:"job_id"`
+}
+
+type themeVersion struct {
+	Which string `json:"which"`
+}
+
+type ThemeServiceOp struct {
+	client *Client
+}
+
+// Get will fetch a single theme by the UUID.
+func (s *ThemeServiceOp) Get(uuid string) (Theme, error) {
+	return s.GetWithContext(context.Background(), uuid)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *ThemeServiceOp) GetWithContext(ctx context.Context, uuid string) (Theme, error) {
+	themeResponse := GetThemeResponse{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/themes/%s", uuid), nil)
+	if reqErr != nil {
+		return themeResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &themeResponse)
+	if jsonErr != nil {
+		return themeResponse.Data, jsonErr
+	}
+	return themeResponse.Data, nil
+}
+
+// List will return the themes of the store.
+func (s *ThemeServiceOp) List() (ListThemeResponse, error) {
+	return s.ListWithContext(context.Background())
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *ThemeServiceOp) ListWithContext(ctx context.Context) (ListThemeResponse, error) {
+	listResult := ListThemeResponse{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, "/v3/themes", nil)
+	if reqErr != nil {
+		return listResult, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// Upload will upload a theme zip file and return the ID of the job that processes it.
+// Use UploadAndWait to wait for the theme to be created.
+func (s *ThemeServiceOp) Upload(filename string, zip io.Reader) (string, error) {
+	return s.UploadWithContext(context.Background(), filename, zip)
+}
+
+// UploadWithContext is the context-aware variant of Upload.
+func (s *ThemeServiceOp) UploadWithContext(ctx context.Context, filename string, zip io.Reader) (string, error) {
+	jobResponse := themeJobIDResponse{}
+	body, reqErr := s.client.DoMultipartRequestWithContext(ctx, http.MethodPost, "/v3/themes", nil, MultipartFile{Field: "file", Filename: filename, Reader: zip})
+	if reqErr != nil {
+		return "", reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &jobResponse)
+	if jsonErr != nil {
+		return "", jsonErr
+	}
+	return jobResponse.JobID, nil
+}
+
+// UploadAndWait will upload a theme zip file, wait for it to be processed and
+// return the UUID of the new theme.
+func (s *ThemeServiceOp) UploadAndWait(filename string, zip io.Reader, options ...JobWaitOptions) (string, error) {
+	return s.UploadAndWaitWithContext(context.Background(), filename, zip, options...)
+}
+
+// UploadAndWaitWithContext is the context-aware variant of UploadAndWait.
+func (s *ThemeServiceOp) UploadAndWaitWithContext(ctx context.Context, filename string, zip io.Reader, options ...JobWaitOptions) (string, error) {
+	jobID, err := s.UploadWithContext(ctx, filename, zip)
+	if err != nil {
+		return "", err
+	}
+
+	job, err := s.WaitForJobWithContext(ctx, jobID, options...)
+	if err != nil {
+		return "", err
+	}
+	return job.Result.ThemeID, nil
+}
+
+// Download will start preparing a version of a theme for download and return the
+// ID of the job. Use DownloadAndWait to wait for the download URL.
+func (s *ThemeServiceOp) Download(uuid, which string) (string, error) {
+	return s.DownloadWithContext(context.Background(), uuid, which)
+}
+
+// DownloadWithContext is the context-aware variant of Download.
+func (s *ThemeServiceOp) DownloadWithContext(ctx context.Context, uuid, which string) (string, error) {
+	jobResponse := themeJobIDResponse{}
+	jsonBody, err := json.Marshal(themeVersion{Which: which})
+	if err != nil {
+		return "", err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("/v3/themes/%s/actions/download", uuid), reqBody)
+	if reqErr != nil {
+		return "", reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &jobResponse)
+	if jsonErr != nil {
+		return "", jsonErr
+	}
+	return jobResponse.JobID, nil
+}
+
+// DownloadAndWait will prepare a version of a theme for download, wait for it and
+// return the URL the zip file can be downloaded from.
+func (s *ThemeServiceOp) DownloadAndWait(uuid, which string, options ...JobWaitOptions) (string, error) {
+	return s.DownloadAndWaitWithContext(context.Background(), uuid, which, options...)
+}
+
+// DownloadAndWaitWithContext is the context-aware variant of DownloadAndWait.
+func (s *ThemeServiceOp) DownloadAndWaitWithContext(ctx context.Context, uuid, which string, options ...JobWaitOptions) (string, error) {
+	jobID, err := s.DownloadWithContext(ctx, uuid, which)
+	if err != nil {
+		return "", err
+	}
+
+	job, err := s.WaitForJobWithContext(ctx, jobID, options...)
+	if err != nil {
+		return "", err
+	}
+	return job.Result.DownloadURL, nil
+}
+
+// Activate will make a variation of a theme the active theme of the store, or of a channel.
+func (s *ThemeServiceOp) Activate(activation ThemeActivation) error {
+	return s.ActivateWithContext(context.Background(), activation)
+}
+
+// ActivateWithContext is the context-aware variant of Activate.
+func (s *ThemeServiceOp) ActivateWithContext(ctx context.Context, activation ThemeActivation) error {
+	jsonBody, err := json.Marshal(activation)
+	if err != nil {
+		return err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, "/v3/themes/actions/activate", reqBody)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// Delete will delete a theme by the UUID. Active and default themes cannot be deleted.
+func (s *ThemeServiceOp) Delete(uuid string) error {
+	return s.DeleteWithContext(context.Background(), uuid)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *ThemeServiceOp) DeleteWithContext(ctx context.Context, uuid string) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/themes/%s", uuid), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// GetJob will fetch the state of a theme upload or download job.
+func (s *ThemeServiceOp) GetJob(jobID string) (ThemeJob, error) {
+	return s.GetJobWithContext(context.Background(), jobID)
+}
+
+// GetJobWithContext is the context-aware variant of GetJob.
+func (s *ThemeServiceOp) GetJobWithContext(ctx context.Context, jobID string) (ThemeJob, error) {
+	jobResponse := GetThemeJobResponse{}
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/themes/jobs/%s", jobID), nil)
+	if reqErr != nil {
+		return jobResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &jobResponse)
+	if jsonErr != nil {
+		return jobResponse.Data, jsonErr
+	}
+	return jobResponse.Data, nil
+}
+
+// WaitForJob will poll a theme job until it completes, or ctx is done.
+// Jobs that fail are returned with a *ThemeJobError.
+func (s *ThemeServiceOp) WaitForJob(jobID string, options ...JobWaitOptions) (ThemeJob, error) {
+	return s.WaitForJobWithContext(context.Background(), jobID, options...)
+}
+
+// WaitForJobWithContext is the context-aware variant of WaitForJob.
+func (s *ThemeServiceOp) WaitForJobWithContext(ctx context.Context, jobID string, options ...JobWaitOptions) (ThemeJob, error) {
+	interval := jobPollInterval(options)
+	for {
+		job, err := s.GetJobWithContext(ctx, jobID)
+		if err != nil {
+			return job, err
+		}
+
+		switch job.Status {
+		case ThemeJobCompleted:
+			return job, nil
+		case ThemeJobFailed:
+			return job, &ThemeJobError{Job: job}
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			return job, err
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package bigcommerce
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestThemeWaitForJobPollsUntilComplete(t *testing.T) {
+	var calls int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/themes/jobs/job-1" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.Write([]byte(`{"data":{"id":"job-1","status":"WORKING","percent_complete":50}}`))
+			return
+		}
+		w.Write([]byte(`{"data":{"id":"job-1","status":"COMPLETED","percent_complete":100,"result":{"theme_id":"theme-uuid"}}}`))
+	})
+
+	job, err := client.Themes.WaitForJob("job-1", JobWaitOptions{PollInterval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("WaitForJob: %v", err)
+	}
+	if job.Result.ThemeID != "theme-uuid" {
+		t.Errorf("job = %+v", job)
+	}
+	if calls != 3 {
+		t.Errorf("calls = %d, want 3", calls)
+	}
+}
+
+func TestThemeWaitForJobReportsFailure(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":{"id":"job-1","status":"FAILED","errors":[{"error":"invalid_zip","message":"The theme archive is invalid."}]}}`))
+	})
+
+	_, err := client.Themes.WaitForJob("job-1", JobWaitOptions{PollInterval: time.Millisecond})
+
+	var jobErr *ThemeJobError
+	if !errors.As(err, &jobErr) {
+		t.Fatalf("err = %v, want a *ThemeJobError", err)
+	}
+	if want := "bigcommerce: theme job job-1 failed: The theme archive is invalid."; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestThemeWaitForJobStopsWhenCancelled(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":{"id":"job-1","status":"WORKING"}}`))
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := client.Themes.WaitForJobWithContext(ctx, "job-1", JobWaitOptions{PollInterval: time.Millisecond}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v, want the context's error", err)
+	}
+}
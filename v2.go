@@ -0,0 +1,59 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+)
+
+// Decimal is a decimal amount, such as a price, that the v2 API encodes as a
+// string ("12.5000") to avoid floating point rounding. It also accepts JSON numbers.
+type Decimal string
+
+// NewDecimal formats f as a Decimal with four decimal places.
+func NewDecimal(f float64) Decimal {
+	return Decimal(strconv.FormatFloat(f, 'f', 4, 64))
+}
+
+// Float64 parses the amount. An empty Decimal is zero.
+func (d Decimal) Float64() (float64, error) {
+	if d == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(string(d), 64)
+}
+
+// UnmarshalJSON accepts a JSON string, number or null.
+func (d *Decimal) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		*d = ""
+		return nil
+	}
+
+	if len(b) > 0 && b[0] == '"' {
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		*d = Decimal(s)
+		return nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(b, &n); err != nil {
+		return err
+	}
+	*d = Decimal(n.String())
+	return nil
+}
+
+// v2Page reports whether a v2 endpoint, which returns no pagination metadata,
+// may have further pages: only a full page implies there are more.
+type v2Page struct {
+	count int
+	limit int
+}
+
+func (p v2Page) HasNextPage() bool {
+	return p.count > 0 && p.count >= p.limit
+}
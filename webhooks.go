@@ -2,17 +2,24 @@ package bigcommerce
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 )
 
 type WebhooksService interface {
-	Get(int64, ...interface{}) (Webhook, error)
-	List(...interface{}) ([]Webhook, error)
-	Create(Webhook, ...interface{}) (Webhook, error)
-	Update(Webhook, ...interface{}) (Webhook, error)
-	Delete(int64, ...interface{}) error
+	Get(int64) (Webhook, error)
+	GetWithContext(context.Context, int64) (Webhook, error)
+	List(...ListWebhooksOptions) ([]Webhook, error)
+	ListWithContext(context.Context, ...ListWebhooksOptions) ([]Webhook, error)
+	Create(Webhook) (Webhook, error)
+	CreateWithContext(context.Context, Webhook) (Webhook, error)
+	Update(Webhook) (Webhook, error)
+	UpdateWithContext(context.Context, Webhook) (Webhook, error)
+	Delete(int64) error
+	DeleteWithContext(context.Context, int64) error
+	Iterate(context.Context, IteratorOptions, ...ListWebhooksOptions) *Iterator
 }
 
 type WebhookPaginationResult struct {
@@ -47,14 +54,27 @@ type Webhook struct {
 	Headers     map[string]string `json:"headers"`
 }
 
+// ListWebhooksOptions filters the webhooks returned by List.
+type ListWebhooksOptions struct {
+	ListOptions
+	IsActive    *bool  `url:"is_active,omitempty"`
+	Scope       string `url:"scope,omitempty"`
+	Destination string `url:"destination,omitempty"`
+}
+
 type WebhooksServiceOp struct {
 	client *Client
 }
 
 // Get will fetch a single webhook by the provided ID.
-func (s *WebhooksServiceOp) Get(id int64, options ...interface{}) (Webhook, error) {
+func (s *WebhooksServiceOp) Get(id int64) (Webhook, error) {
+	return s.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *WebhooksServiceOp) GetWithContext(ctx context.Context, id int64) (Webhook, error) {
 	var webhookResponse GetWebhookResponse
-	body, reqErr := s.client.DoRequest(http.MethodGet, fmt.Sprintf("/v3/hooks/%d", id), nil)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/hooks/%d", id), nil)
 	if reqErr != nil {
 		return webhookResponse.Data, reqErr
 	}
@@ -65,30 +85,56 @@ func (s *WebhooksServiceOp) Get(id int64, options ...interface{}) (Webhook, erro
 	return webhookResponse.Data, nil
 }
 
-// List will retrieve all webhooks
-func (s *WebhooksServiceOp) List(options ...interface{}) ([]Webhook, error) {
+// List will retrieve a page of webhooks matching the options.
+func (s *WebhooksServiceOp) List(options ...ListWebhooksOptions) ([]Webhook, error) {
+	return s.ListWithContext(context.Background(), options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *WebhooksServiceOp) ListWithContext(ctx context.Context, options ...ListWebhooksOptions) ([]Webhook, error) {
+	webhookListResponse, err := s.list(ctx, options...)
+	return webhookListResponse.Data, err
+}
+
+func (s *WebhooksServiceOp) list(ctx context.Context, options ...ListWebhooksOptions) (ListWebhookResponse, error) {
 	webhookListResponse := ListWebhookResponse{}
-	body, reqErr := s.client.DoRequest(http.MethodGet, "/v3/hooks", nil)
+
+	var listOptions ListWebhooksOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/hooks", listOptions)
+	if err != nil {
+		return webhookListResponse, err
+	}
+
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
 	if reqErr != nil {
-		return webhookListResponse.Data, reqErr
+		return webhookListResponse, reqErr
 	}
 	jsonErr := json.Unmarshal(body, &webhookListResponse)
 	if jsonErr != nil {
-		return webhookListResponse.Data, jsonErr
+		return webhookListResponse, jsonErr
 	}
-	return webhookListResponse.Data, nil
+	return webhookListResponse, nil
 }
 
 // Create will create a new webhook.
 // The only fields required on a webhook are: Scope, Destination and IsActive
-func (s *WebhooksServiceOp) Create(webhook Webhook, options ...interface{}) (Webhook, error) {
+func (s *WebhooksServiceOp) Create(webhook Webhook) (Webhook, error) {
+	return s.CreateWithContext(context.Background(), webhook)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *WebhooksServiceOp) CreateWithContext(ctx context.Context, webhook Webhook) (Webhook, error) {
 	var webhookResponse GetWebhookResponse
 	jsonBody, err := json.Marshal(webhook)
 	if err != nil {
 		return webhookResponse.Data, err
 	}
 	reqBody := bytes.NewReader(jsonBody)
-	body, reqErr := s.client.DoRequest(http.MethodPost, "/v3/hooks", reqBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, "/v3/hooks", reqBody)
 	if reqErr != nil {
 		return webhookResponse.Data, reqErr
 	}
@@ -102,14 +148,19 @@ func (s *WebhooksServiceOp) Create(webhook Webhook, options ...interface{}) (Web
 }
 
 // Update will update a single webhook.
-func (s *WebhooksServiceOp) Update(webhook Webhook, options ...interface{}) (Webhook, error) {
+func (s *WebhooksServiceOp) Update(webhook Webhook) (Webhook, error) {
+	return s.UpdateWithContext(context.Background(), webhook)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *WebhooksServiceOp) UpdateWithContext(ctx context.Context, webhook Webhook) (Webhook, error) {
 	var webhookResponse GetWebhookResponse
 	jsonBody, err := json.Marshal(webhook)
 	if err != nil {
 		return webhookResponse.Data, err
 	}
 	reqBody := bytes.NewReader(jsonBody)
-	body, reqErr := s.client.DoRequest(http.MethodPut, fmt.Sprintf("/v3/hooks/%d", webhook.ID), reqBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/hooks/%d", webhook.ID), reqBody)
 	if reqErr != nil {
 		return webhookResponse.Data, reqErr
 	}
@@ -123,10 +174,35 @@ func (s *WebhooksServiceOp) Update(webhook Webhook, options ...interface{}) (Web
 }
 
 // Delete will delete a webhook by the provided ID.
-func (s *WebhooksServiceOp) Delete(id int64, options ...interface{}) error {
-	_, reqErr := s.client.DoRequest(http.MethodDelete, fmt.Sprintf("/v3/hooks/%d", id), nil)
+func (s *WebhooksServiceOp) Delete(id int64) error {
+	return s.DeleteWithContext(context.Background(), id)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *WebhooksServiceOp) DeleteWithContext(ctx context.Context, id int64) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/hooks/%d", id), nil)
 	if reqErr != nil {
 		return reqErr
 	}
 	return nil
 }
+
+// Iterate will walk every webhook matching the options, fetching further pages as needed.
+func (s *WebhooksServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, options ...ListWebhooksOptions) *Iterator {
+	var listOptions ListWebhooksOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.list(ctx, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
@@ -0,0 +1,197 @@
+package bigcommerce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type WidgetService interface {
+	Get(string) (Widget, error)
+	GetWithContext(context.Context, string) (Widget, error)
+	List(...ListWidgetsOptions) (ListWidgetResponse, error)
+	ListWithContext(context.Context, ...ListWidgetsOptions) (ListWidgetResponse, error)
+	Create(Widget) (Widget, error)
+	CreateWithContext(context.Context, Widget) (Widget, error)
+	Update(Widget) (Widget, error)
+	UpdateWithContext(context.Context, Widget) (Widget, error)
+	Delete(string) error
+	DeleteWithContext(context.Context, string) error
+	Iterate(context.Context, IteratorOptions, ...ListWidgetsOptions) *Iterator
+}
+
+type GetWidgetResponse struct {
+	Data Widget `json:"data"`
+}
+
+type ListWidgetResponse struct {
+	Data []Widget   `json:"data"`
+	Meta MetaResult `json:"meta"`
+}
+
+// Widget structure.
+// When creating or updating a widget, set WidgetTemplateUUID; the API returns
+// the full template in WidgetTemplate.
+type Widget struct {
+	UUID                     string                 `json:"uuid,omitempty"`
+	Name                     string                 `json:"name,omitempty"`
+	Description              string                 `json:"description,omitempty"`
+	WidgetConfiguration      map[string]interface{} `json:"widget_configuration,omitempty"`
+	WidgetTemplateUUID       string                 `json:"widget_template_uuid,omitempty"`
+	WidgetTemplate           *WidgetTemplate        `json:"widget_template,omitempty"`
+	StorefrontAPIQueryParams map[string]interface{} `json:"storefront_api_query_params,omitempty"`
+	ChannelID                int                    `json:"channel_id,omitempty"`
+	VersionUUID              string                 `json:"version_uuid,omitempty"`
+	DateCreated              string                 `json:"date_created,omitempty"`
+	DateModified             string                 `json:"date_modified,omitempty"`
+}
+
+// ListWidgetsOptions filters the widgets returned by List.
+type ListWidgetsOptions struct {
+	ListOptions
+	WidgetTemplateKind string `url:"widget_template_kind,omitempty"`
+	WidgetTemplateUUID string `url:"widget_template_uuid,omitempty"`
+	ChannelID          int    `url:"channel_id,omitempty"`
+}
+
+type WidgetServiceOp struct {
+	client *Client
+}
+
+// Get will retrieve a widget by the UUID
+func (s *WidgetServiceOp) Get(uuid string) (Widget, error) {
+	return s.GetWithContext(context.Background(), uuid)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *WidgetServiceOp) GetWithContext(ctx context.Context, uuid string) (Widget, error) {
+	widgetResponse := GetWidgetResponse{}
+	body, err := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/content/widgets/%s", uuid), nil)
+	if err != nil {
+		return widgetResponse.Data, err
+	}
+
+	jsonErr := json.Unmarshal(body, &widgetResponse)
+	if jsonErr != nil {
+		return widgetResponse.Data, jsonErr
+	}
+
+	return widgetResponse.Data, nil
+}
+
+// List will return a page of widgets matching the options.
+func (s *WidgetServiceOp) List(options ...ListWidgetsOptions) (ListWidgetResponse, error) {
+	return s.ListWithContext(context.Background(), options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *WidgetServiceOp) ListWithContext(ctx context.Context, options ...ListWidgetsOptions) (ListWidgetResponse, error) {
+	listResult := ListWidgetResponse{}
+
+	var listOptions ListWidgetsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/content/widgets", listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, err := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return listResult, err
+	}
+
+	jsonErr := json.Unmarshal(body, &listResult)
+	if jsonErr != nil {
+		return listResult, jsonErr
+	}
+	return listResult, nil
+}
+
+// Create will create a new widget from a widget template.
+func (s *WidgetServiceOp) Create(widget Widget) (Widget, error) {
+	return s.CreateWithContext(context.Background(), widget)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *WidgetServiceOp) CreateWithContext(ctx context.Context, widget Widget) (Widget, error) {
+	widgetResponse := GetWidgetResponse{}
+	jsonBody, err := json.Marshal(widget)
+	if err != nil {
+		return widgetResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, "/v3/content/widgets", reqBody)
+	if reqErr != nil {
+		return widgetResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &widgetResponse)
+	if jsonErr != nil {
+		return widgetResponse.Data, jsonErr
+	}
+	return widgetResponse.Data, nil
+}
+
+// Update will update a single widget.
+func (s *WidgetServiceOp) Update(widget Widget) (Widget, error) {
+	return s.UpdateWithContext(context.Background(), widget)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *WidgetServiceOp) UpdateWithContext(ctx context.Context, widget Widget) (Widget, error) {
+	widgetResponse := GetWidgetResponse{}
+	jsonBody, err := json.Marshal(widget)
+	if err != nil {
+		return widgetResponse.Data, err
+	}
+	reqBody := bytes.NewReader(jsonBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/content/widgets/%s", widget.UUID), reqBody)
+	if reqErr != nil {
+		return widgetResponse.Data, reqErr
+	}
+
+	jsonErr := json.Unmarshal(body, &widgetResponse)
+	if jsonErr != nil {
+		return widgetResponse.Data, jsonErr
+	}
+	return widgetResponse.Data, nil
+}
+
+// Delete will delete a widget by the provided UUID.
+func (s *WidgetServiceOp) Delete(uuid string) error {
+	return s.DeleteWithContext(context.Background(), uuid)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *WidgetServiceOp) DeleteWithContext(ctx context.Context, uuid string) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/content/widgets/%s", uuid), nil)
+	if reqErr != nil {
+		return reqErr
+	}
+	return nil
+}
+
+// Iterate will walk every widget matching the options, fetching further pages as needed.
+func (s *WidgetServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, options ...ListWidgetsOptions) *Iterator {
+	var listOptions ListWidgetsOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListWithContext(ctx, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}
@@ -2,17 +2,24 @@ package bigcommerce
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 )
 
 type WidgetTemplateService interface {
-	Get(string, ...interface{}) (WidgetTemplate, error)
-	List(...interface{}) (ListWidgetTemplateResponse, error)
-	Create(WidgetTemplate, ...interface{}) (WidgetTemplate, error)
-	Update(WidgetTemplate, ...interface{}) (WidgetTemplate, error)
-	Delete(string, ...interface{}) error
+	Get(string) (WidgetTemplate, error)
+	GetWithContext(context.Context, string) (WidgetTemplate, error)
+	List(...ListWidgetTemplatesOptions) (ListWidgetTemplateResponse, error)
+	ListWithContext(context.Context, ...ListWidgetTemplatesOptions) (ListWidgetTemplateResponse, error)
+	Create(WidgetTemplate) (WidgetTemplate, error)
+	CreateWithContext(context.Context, WidgetTemplate) (WidgetTemplate, error)
+	Update(WidgetTemplate) (WidgetTemplate, error)
+	UpdateWithContext(context.Context, WidgetTemplate) (WidgetTemplate, error)
+	Delete(string) error
+	DeleteWithContext(context.Context, string) error
+	Iterate(context.Context, IteratorOptions, ...ListWidgetTemplatesOptions) *Iterator
 }
 
 type GetWidgetTemplateResponse struct {
@@ -38,14 +45,26 @@ type WidgetTemplate struct {
 	IconName           string        `json:"icon_name,omitempty"`
 }
 
+// ListWidgetTemplatesOptions filters the widget templates returned by List.
+type ListWidgetTemplatesOptions struct {
+	ListOptions
+	WidgetTemplateKind string `url:"widget_template_kind,omitempty"`
+	ChannelID          int    `url:"channel_id,omitempty"`
+}
+
 type WidgetTemplateServiceOp struct {
 	client *Client
 }
 
 // Get will retrieve a widget template by the UUID
-func (s *WidgetTemplateServiceOp) Get(uuid string, options ...interface{}) (WidgetTemplate, error) {
+func (s *WidgetTemplateServiceOp) Get(uuid string) (WidgetTemplate, error) {
+	return s.GetWithContext(context.Background(), uuid)
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (s *WidgetTemplateServiceOp) GetWithContext(ctx context.Context, uuid string) (WidgetTemplate, error) {
 	widgetTemplateResponse := GetWidgetTemplateResponse{}
-	body, err := s.client.DoRequest(http.MethodGet, fmt.Sprintf("/content/widget-templates/%s", uuid), nil)
+	body, err := s.client.DoRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("/v3/content/widget-templates/%s", uuid), nil)
 	if err != nil {
 		return widgetTemplateResponse.Data, err
 	}
@@ -58,10 +77,26 @@ func (s *WidgetTemplateServiceOp) Get(uuid string, options ...interface{}) (Widg
 	return widgetTemplateResponse.Data, nil
 }
 
-// List will return a page of widget templates.
-func (s *WidgetTemplateServiceOp) List(options ...interface{}) (ListWidgetTemplateResponse, error) {
+// List will return a page of widget templates matching the options.
+func (s *WidgetTemplateServiceOp) List(options ...ListWidgetTemplatesOptions) (ListWidgetTemplateResponse, error) {
+	return s.ListWithContext(context.Background(), options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func (s *WidgetTemplateServiceOp) ListWithContext(ctx context.Context, options ...ListWidgetTemplatesOptions) (ListWidgetTemplateResponse, error) {
 	listResult := ListWidgetTemplateResponse{}
-	body, err := s.client.DoRequest(http.MethodGet, "/content/widget-templates/", nil)
+
+	var listOptions ListWidgetTemplatesOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	path, err := addQuery("/v3/content/widget-templates", listOptions)
+	if err != nil {
+		return listResult, err
+	}
+
+	body, err := s.client.DoRequestWithContext(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return listResult, err
 	}
@@ -74,14 +109,19 @@ func (s *WidgetTemplateServiceOp) List(options ...interface{}) (ListWidgetTempla
 }
 
 // Create should do a thing.
-func (s *WidgetTemplateServiceOp) Create(widgetTemplate WidgetTemplate, options ...interface{}) (WidgetTemplate, error) {
+func (s *WidgetTemplateServiceOp) Create(widgetTemplate WidgetTemplate) (WidgetTemplate, error) {
+	return s.CreateWithContext(context.Background(), widgetTemplate)
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (s *WidgetTemplateServiceOp) CreateWithContext(ctx context.Context, widgetTemplate WidgetTemplate) (WidgetTemplate, error) {
 	widgetTemplateResponse := GetWidgetTemplateResponse{}
 	jsonBody, err := json.Marshal(widgetTemplate)
 	if err != nil {
 		return widgetTemplateResponse.Data, err
 	}
 	reqBody := bytes.NewReader(jsonBody)
-	body, reqErr := s.client.DoRequest(http.MethodPost, "/content/widget-templates/", reqBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPost, "/v3/content/widget-templates", reqBody)
 	if reqErr != nil {
 		return widgetTemplateResponse.Data, reqErr
 	}
@@ -94,14 +134,19 @@ func (s *WidgetTemplateServiceOp) Create(widgetTemplate WidgetTemplate, options
 }
 
 // Update should do a thing.
-func (s *WidgetTemplateServiceOp) Update(widgetTemplate WidgetTemplate, options ...interface{}) (WidgetTemplate, error) {
+func (s *WidgetTemplateServiceOp) Update(widgetTemplate WidgetTemplate) (WidgetTemplate, error) {
+	return s.UpdateWithContext(context.Background(), widgetTemplate)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (s *WidgetTemplateServiceOp) UpdateWithContext(ctx context.Context, widgetTemplate WidgetTemplate) (WidgetTemplate, error) {
 	widgetTemplateResponse := GetWidgetTemplateResponse{}
 	jsonBody, err := json.Marshal(widgetTemplate)
 	if err != nil {
 		return widgetTemplateResponse.Data, err
 	}
 	reqBody := bytes.NewReader(jsonBody)
-	body, reqErr := s.client.DoRequest(http.MethodPut, fmt.Sprintf("/content/widget-templates/%s", widgetTemplate.UUID), reqBody)
+	body, reqErr := s.client.DoRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("/v3/content/widget-templates/%s", widgetTemplate.UUID), reqBody)
 	if reqErr != nil {
 		return widgetTemplateResponse.Data, reqErr
 	}
@@ -114,10 +159,35 @@ func (s *WidgetTemplateServiceOp) Update(widgetTemplate WidgetTemplate, options
 }
 
 // Delete should do a thing.
-func (s *WidgetTemplateServiceOp) Delete(uuid string, options ...interface{}) error {
-	_, reqErr := s.client.DoRequest(http.MethodDelete, fmt.Sprintf("/content/widget-templates/%s", uuid), nil)
+func (s *WidgetTemplateServiceOp) Delete(uuid string) error {
+	return s.DeleteWithContext(context.Background(), uuid)
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (s *WidgetTemplateServiceOp) DeleteWithContext(ctx context.Context, uuid string) error {
+	_, reqErr := s.client.DoRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("/v3/content/widget-templates/%s", uuid), nil)
 	if reqErr != nil {
 		return reqErr
 	}
 	return nil
 }
+
+// Iterate will walk every widget template matching the options, fetching further pages as needed.
+func (s *WidgetTemplateServiceOp) Iterate(ctx context.Context, iteratorOptions IteratorOptions, options ...ListWidgetTemplatesOptions) *Iterator {
+	var listOptions ListWidgetTemplatesOptions
+	if len(options) > 0 {
+		listOptions = options[0]
+	}
+
+	return NewIterator(ctx, func(ctx context.Context, page, limit int) (interface{}, Paginator, error) {
+		pageOptions := listOptions
+		pageOptions.Page = page
+		pageOptions.Limit = limit
+
+		listResult, err := s.ListWithContext(ctx, pageOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return listResult.Data, listResult.Meta.Pagination, nil
+	}, iteratorOptions)
+}